@@ -0,0 +1,161 @@
+/*
+Copyright © 2020 Pavel Tisnovsky
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Command checklinks checks Markdown links written in prose of literate
+// sources. It reports malformed links (for example links split across two
+// lines), duplicate links and links to services that have been moved. With
+// -http, targets of links are requested and dead or redirected links are
+// reported too. Requests can be sent to a local server standing in for the
+// real hosts, or answered from a fixture with cached statuses.
+//
+// Usage:
+//
+//	go run ./cmd/checklinks gonum.go
+//	go run ./cmd/checklinks -http -record links.json gonum.go
+//	go run ./cmd/checklinks -fixture links.json gonum.go
+//	go run ./cmd/checklinks -http -server http://localhost:8080 gonum.go
+package main
+
+import (
+	"flag"
+	"fmt"
+	"net/url"
+	"os"
+	"time"
+
+	"github.com/tisnik/literate-programming-examples/linkcheck"
+	"github.com/tisnik/literate-programming-examples/literate"
+)
+
+// report counts problems and warnings found in all files.
+type report struct {
+	links    int
+	problems int
+	warnings int
+}
+
+// problem prints problem found on given line.
+func (r *report) problem(filename string, line int, format string, args ...interface{}) {
+	fmt.Printf("%s:%d: %s\n", filename, line, fmt.Sprintf(format, args...))
+	r.problems++
+}
+
+// warning prints warning for given line.
+func (r *report) warning(filename string, line int, format string, args ...interface{}) {
+	fmt.Printf("%s:%d: warning: %s\n", filename, line, fmt.Sprintf(format, args...))
+	r.warnings++
+}
+
+// check checks all links in one file, checker is nil when targets of links
+// are not checked.
+func check(filename string, checker *linkcheck.Checker, r *report) error {
+	doc, err := literate.ParseFile(filename)
+	if err != nil {
+		return err
+	}
+	links, problems := doc.Links()
+	r.links += len(links)
+
+	for _, p := range problems {
+		r.problem(filename, p.Line, "%s", p.Message)
+	}
+	for _, d := range literate.DuplicateLinks(links) {
+		r.problem(filename, d.Line, "duplicate link to %s (first linked on line %d)", d.URL, d.First)
+	}
+
+	for _, link := range links {
+		if suggestion := linkcheck.Suggest(link.URL); suggestion != "" {
+			r.warning(filename, link.Line, "%s has moved, use %s", link.URL, suggestion)
+		}
+		if checker == nil || !linkcheck.Checkable(link.URL) {
+			continue
+		}
+		status := checker.Check(link.URL)
+		switch {
+		case status.Dead():
+			r.problem(filename, link.Line, "dead link %s: %s", link.URL, status)
+		case status.Redirected():
+			r.warning(filename, link.Line, "%s: %s", link.URL, status)
+		}
+	}
+	return nil
+}
+
+func main() {
+	checkHTTP := flag.Bool("http", false, "request targets of links")
+	server := flag.String("server", "", "send all requests to this server instead of the real hosts")
+	fixture := flag.String("fixture", "", "JSON file with cached statuses (requests are sent only with -http)")
+	record := flag.String("record", "", "write statuses of all checked links to JSON file")
+	timeout := flag.Duration("timeout", 10*time.Second, "timeout of one request")
+	flag.Parse()
+
+	if flag.NArg() == 0 {
+		fmt.Fprintln(os.Stderr, "usage: checklinks [-http] [-server url] [-fixture file] [-record file] file...")
+		os.Exit(2)
+	}
+	// requests are sent only with -http and statuses to record are known
+	// only with -http or -fixture
+	if *server != "" && !*checkHTTP {
+		fmt.Fprintln(os.Stderr, "-server can be used only with -http")
+		os.Exit(2)
+	}
+	if *record != "" && !*checkHTTP && *fixture == "" {
+		fmt.Fprintln(os.Stderr, "-record can be used only with -http or -fixture")
+		os.Exit(2)
+	}
+
+	var checker *linkcheck.Checker
+	if *checkHTTP || *fixture != "" {
+		checker = linkcheck.NewChecker(*timeout)
+		checker.Offline = !*checkHTTP
+		if *fixture != "" {
+			f, err := linkcheck.LoadFixture(*fixture)
+			if err != nil {
+				fmt.Fprintln(os.Stderr, err)
+				os.Exit(1)
+			}
+			checker.Fixture = f
+		}
+		if *server != "" {
+			u, err := url.Parse(*server)
+			if err != nil {
+				fmt.Fprintln(os.Stderr, err)
+				os.Exit(1)
+			}
+			checker.Server = u
+		}
+	}
+
+	var r report
+	for _, filename := range flag.Args() {
+		if err := check(filename, checker, &r); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+	}
+
+	if *record != "" {
+		if err := checker.Fixture.Save(*record); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+	}
+
+	fmt.Printf("%d links, %d problems, %d warnings\n", r.links, r.problems, r.warnings)
+	if r.problems > 0 {
+		os.Exit(1)
+	}
+}
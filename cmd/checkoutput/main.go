@@ -0,0 +1,117 @@
+/*
+Copyright © 2020 Pavel Tisnovsky
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Command checkoutput compares expected output written in literate sources
+// with the real output of the program. Numbers found in expected output are
+// compared with configurable absolute and relative tolerance, so results
+// like 6.66e-16 instead of 0 are accepted. Differences in layout (column
+// padding etc.) are reported as warnings, all other differences as
+// failures.
+//
+// Usage:
+//
+//	go run ./cmd/checkoutput gonum.go
+//	go run ./cmd/checkoutput -actual output.txt -abs 1e-12 -rel 0 gonum.go
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+
+	"github.com/tisnik/literate-programming-examples/literate"
+)
+
+// runProgram runs given literate source and returns its standard output.
+func runProgram(filename string) ([]byte, error) {
+	var stdout bytes.Buffer
+	cmd := exec.Command("go", "run", filename)
+	cmd.Stdout = &stdout
+	cmd.Stderr = os.Stderr
+	err := cmd.Run()
+	return stdout.Bytes(), err
+}
+
+// check compares all expected blocks with actual output and prints
+// report. Number of warnings and failures is returned.
+func check(doc *literate.Document, output []byte, tolerance literate.Tolerance, verbose bool) (int, int) {
+	actual := strings.Split(strings.TrimRight(string(output), "\n"), "\n")
+	warnings := 0
+	failures := 0
+	from := 0
+
+	for _, block := range doc.BlocksOf(literate.Output) {
+		m := literate.FindBlock(block.Lines, actual, from, tolerance)
+		switch m.Result {
+		case literate.LayoutDiffers:
+			warnings++
+		case literate.Mismatch:
+			failures++
+		}
+		if m.Result != literate.Match || verbose {
+			fmt.Printf("%s:%s\n", doc.Filename, m.Describe(block))
+		}
+		if m.First >= 0 {
+			from = m.Last + 1
+		}
+	}
+	return warnings, failures
+}
+
+func main() {
+	actualFile := flag.String("actual", "", "file with actual output (the program is run when not set)")
+	abs := flag.Float64("abs", literate.DefaultTolerance.Abs, "absolute tolerance")
+	rel := flag.Float64("rel", literate.DefaultTolerance.Rel, "relative tolerance")
+	strict := flag.Bool("strict", false, "treat layout differences as failures")
+	verbose := flag.Bool("v", false, "report matching blocks too")
+	flag.Parse()
+
+	if flag.NArg() != 1 {
+		fmt.Fprintln(os.Stderr, "usage: checkoutput [flags] source.go")
+		flag.PrintDefaults()
+		os.Exit(2)
+	}
+	filename := flag.Arg(0)
+
+	doc, err := literate.ParseFile(filename)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+
+	var output []byte
+	if *actualFile != "" {
+		output, err = os.ReadFile(*actualFile)
+	} else {
+		output, err = runProgram(filename)
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+
+	tolerance := literate.Tolerance{Abs: *abs, Rel: *rel}
+	warnings, failures := check(doc, output, tolerance, *verbose)
+	fmt.Printf("%d expected blocks, %d warnings, %d failures\n",
+		len(doc.BlocksOf(literate.Output)), warnings, failures)
+
+	if failures > 0 || (*strict && warnings > 0) {
+		os.Exit(1)
+	}
+}
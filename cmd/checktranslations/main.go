@@ -0,0 +1,169 @@
+/*
+Copyright © 2020 Pavel Tisnovsky
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Command checktranslations reports prose in literate sources that is not
+// translated to all languages used in the document, or whose translation
+// is older than the prose in the default language. Age of each line is
+// taken from git blame, lines that are not committed yet are considered to
+// be the newest ones.
+//
+// Usage:
+//
+//	go run ./cmd/checktranslations gonum.go
+//	go run ./cmd/checktranslations -lang en,de gonum.go gonum_spy.go
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"flag"
+	"fmt"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/tisnik/literate-programming-examples/literate"
+)
+
+// blame returns time of the last change of each line of given file,
+// indexed by line number (starting from 1).
+func blame(filename string) (map[int]time.Time, error) {
+	var stderr bytes.Buffer
+	cmd := exec.Command("git", "blame", "--line-porcelain", "--", filename)
+	cmd.Stderr = &stderr
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("git blame %s: %w: %s", filename, err, strings.TrimSpace(stderr.String()))
+	}
+
+	times := map[int]time.Time{}
+	line := 0
+	uncommitted := false
+	scanner := bufio.NewScanner(bytes.NewReader(out))
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		text := scanner.Text()
+		fields := strings.Fields(text)
+		switch {
+		case strings.HasPrefix(text, "\t") || len(fields) == 0:
+			// content of line ends the entry
+		case len(fields) >= 3 && len(fields[0]) == 40:
+			// header: commit, original line, final line
+			line, _ = strconv.Atoi(fields[2])
+			uncommitted = strings.Trim(fields[0], "0") == ""
+		case fields[0] == "committer-time" && len(fields) == 2:
+			seconds, err := strconv.ParseInt(fields[1], 10, 64)
+			if err != nil {
+				return nil, err
+			}
+			times[line] = time.Unix(seconds, 0)
+			if uncommitted {
+				times[line] = time.Now()
+			}
+		}
+	}
+	return times, scanner.Err()
+}
+
+// newest returns time of the newest line in blocks.
+func newest(blocks []literate.Block, times map[int]time.Time) time.Time {
+	var t time.Time
+	for _, b := range blocks {
+		for line := b.Start; line <= b.End; line++ {
+			if times[line].After(t) {
+				t = times[line]
+			}
+		}
+	}
+	return t
+}
+
+// check reports missing and outdated translations in one file and returns
+// number of problems found.
+func check(filename, source string, langs []string) (int, error) {
+	doc, err := literate.ParseFile(filename)
+	if err != nil {
+		return 0, err
+	}
+	doc.SetDefault(source)
+	if len(langs) == 0 {
+		langs = doc.Translations()
+	}
+	if len(langs) == 0 {
+		return 0, nil
+	}
+	times, err := blame(filename)
+	if err != nil {
+		return 0, err
+	}
+
+	problems := 0
+	for _, group := range doc.ProseGroups() {
+		original := group.Blocks[""]
+		if len(original) == 0 {
+			continue
+		}
+		source := newest(original, times)
+		for _, lang := range langs {
+			translation := group.Blocks[lang]
+			switch {
+			case len(translation) == 0:
+				fmt.Printf("%s:%d-%d: missing %s translation\n",
+					filename, original[0].Start, original[len(original)-1].End, lang)
+				problems++
+			case newest(translation, times).Before(source):
+				fmt.Printf("%s:%d-%d: %s translation is older than source (%s < %s)\n",
+					filename, translation[0].Start, translation[len(translation)-1].End, lang,
+					newest(translation, times).Format("2006-01-02"), source.Format("2006-01-02"))
+				problems++
+			}
+		}
+	}
+	return problems, nil
+}
+
+func main() {
+	source := flag.String("source", "cs", "language of prose without language marker")
+	langList := flag.String("lang", "", "comma separated list of required languages (all languages used in file by default)")
+	flag.Parse()
+
+	if flag.NArg() == 0 {
+		fmt.Fprintln(os.Stderr, "usage: checktranslations [-source cs] [-lang en,de] file...")
+		os.Exit(2)
+	}
+	var langs []string
+	for _, lang := range strings.Split(*langList, ",") {
+		if lang = strings.TrimSpace(lang); lang != "" {
+			langs = append(langs, lang)
+		}
+	}
+
+	problems := 0
+	for _, filename := range flag.Args() {
+		n, err := check(filename, *source, langs)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		problems += n
+	}
+	if problems > 0 {
+		fmt.Printf("%d problems found\n", problems)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,216 @@
+/*
+Copyright © 2020 Pavel Tisnovsky
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Command consumerstats is a Go port of the analysis performed in
+// consumer_benchmarks.py. It reads both CSV files produced from consumer
+// logs and computes throughput (messages per second), statistic of
+// overall and per-step durations including percentiles, and estimates
+// speedup achievable by multiple consumers.
+//
+// Usage:
+//
+//	go run ./cmd/consumerstats -dir benchmark_results
+//	go run ./cmd/consumerstats -messages 100000 -minutes 26 -consumers 32
+//	go run ./cmd/consumerstats -backend psql -statistic p95 -plot speedup.png
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/tisnik/literate-programming-examples/consumer"
+	"github.com/tisnik/literate-programming-examples/speedup"
+)
+
+// configuration contains all command line options.
+type configuration struct {
+	dir       string
+	messages  int
+	minutes   float64
+	consumers int
+	serial    string
+	backend   string
+	statistic string
+	plot      string
+}
+
+// mainResults prints throughput measured by the time tool, i.e. total
+// number of messages consumed in known time.
+func mainResults(cfg configuration) {
+	seconds := cfg.minutes * 60
+	perSecond := float64(cfg.messages) / seconds
+
+	fmt.Println("# Main results")
+	fmt.Printf("Messages:   %d\n", cfg.messages)
+	fmt.Printf("Time:       %g s\n", seconds)
+	fmt.Printf("Per second: %d\n", int(perSecond))
+	fmt.Printf("Per minute: %d\n", int(perSecond*60))
+	fmt.Println()
+}
+
+// overallDurations prints statistic of whole durations (in milliseconds)
+// and throughput in best, average and worst scenario.
+func overallDurations(cfg configuration) error {
+	table, err := consumer.ReadTableFile(filepath.Join(cfg.dir, consumer.DurationsFile))
+	if err != nil {
+		return err
+	}
+	durations, err := table.Column(consumer.DurationColumn)
+	if err != nil {
+		return err
+	}
+	stats := consumer.Describe(durations)
+
+	fmt.Println("# Duration of consume message operation (ms)")
+	if err := consumer.WriteStats(os.Stdout, []string{consumer.DurationColumn}, []consumer.Stats{stats}); err != nil {
+		return err
+	}
+	fmt.Println()
+
+	fmt.Println("# Throughput (messages per second)")
+	fmt.Printf("Best:    %.1f\n", consumer.PerSecond(stats.Min, 1e3))
+	fmt.Printf("Average: %.1f\n", consumer.PerSecond(stats.Mean, 1e3))
+	fmt.Printf("Median:  %.1f\n", consumer.PerSecond(stats.Median, 1e3))
+	fmt.Printf("Worst:   %.1f\n", consumer.PerSecond(stats.Max, 1e3))
+	fmt.Println()
+	return nil
+}
+
+// stepsDurations prints statistic of all steps (in nanoseconds), relative
+// time spent in each step and the speedup estimation.
+func stepsDurations(cfg configuration) error {
+	table, err := consumer.ReadTableFile(filepath.Join(cfg.dir, consumer.StepsDurationsFile))
+	if err != nil {
+		return err
+	}
+
+	stats := make([]consumer.Stats, len(consumer.Steps))
+	total := 0.0
+	for i, step := range consumer.Steps {
+		values, err := table.Column(step)
+		if err != nil {
+			return err
+		}
+		stats[i] = consumer.Describe(values)
+		total += stats[i].Mean
+	}
+
+	fmt.Println("# Duration of individual steps (ns)")
+	if err := consumer.WriteStats(os.Stdout, consumer.Steps, stats); err != nil {
+		return err
+	}
+	fmt.Println()
+
+	fmt.Println("# Relative time spent in steps")
+	tw := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', tabwriter.AlignRight)
+	for i, step := range consumer.Steps {
+		fmt.Fprintf(tw, "%s\t%.1f %%\t\n", step, 100*stats[i].Mean/total)
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+	fmt.Println()
+
+	return possibleSpeedup(cfg, stats)
+}
+
+// serialSteps returns steps that can not be performed in parallel, either
+// for selected storage backend or as specified explicitly.
+func serialSteps(cfg configuration) ([]string, error) {
+	if cfg.backend != "" {
+		serial, found := speedup.Backends[cfg.backend]
+		if !found {
+			return nil, fmt.Errorf("unknown backend %q", cfg.backend)
+		}
+		return serial, nil
+	}
+	var serial []string
+	for _, step := range strings.Split(cfg.serial, ",") {
+		if step = strings.TrimSpace(step); step != "" {
+			serial = append(serial, step)
+		}
+	}
+	return serial, nil
+}
+
+// possibleSpeedup prints throughput estimation for 1..N consumers computed
+// by Amdahl's and Gustafson's law. By default DB store is the only serial
+// step, as in the original measurement with SQLite.
+func possibleSpeedup(cfg configuration, stats []consumer.Stats) error {
+	serial, err := serialSteps(cfg)
+	if err != nil {
+		return err
+	}
+	steps, err := speedup.FromStats(consumer.Steps, stats, cfg.statistic, serial)
+	if err != nil {
+		return err
+	}
+	predictions, err := speedup.Predict(steps, cfg.consumers, 1e9)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("# Possible speedup (%s durations)\n", cfg.statistic)
+	if err := speedup.WriteSteps(os.Stdout, steps); err != nil {
+		return err
+	}
+	fmt.Println()
+	fmt.Printf("Parallel fraction:   %.3f\n", speedup.ParallelFraction(steps))
+	fmt.Printf("Throughput for 1:    %.1f per second\n", predictions[0].AmdahlThroughput)
+	fmt.Println()
+
+	if err := speedup.WriteTable(os.Stdout, predictions); err != nil {
+		return err
+	}
+	if cfg.plot != "" {
+		return speedup.Plot(predictions, "Possible speedup", cfg.plot)
+	}
+	return nil
+}
+
+func main() {
+	var cfg configuration
+	flag.StringVar(&cfg.dir, "dir", ".", "directory with CSV files")
+	flag.IntVar(&cfg.messages, "messages", 100000, "number of consumed messages")
+	flag.Float64Var(&cfg.minutes, "minutes", 26, "time needed to consume all messages (minutes)")
+	flag.IntVar(&cfg.consumers, "consumers", 32, "maximum number of consumers for speedup estimation")
+	flag.StringVar(&cfg.serial, "serial", strings.Join(speedup.Backends["sqlite"], ","),
+		"comma separated list of steps that can not be performed in parallel")
+	flag.StringVar(&cfg.backend, "backend", "", "storage backend (sqlite or psql), overrides -serial")
+	flag.StringVar(&cfg.statistic, "statistic", "mean", "duration used for prediction (mean, median, min, max, p90, p95, p99)")
+	flag.StringVar(&cfg.plot, "plot", "", "file to store plot with predicted throughput (.png, .svg, .pdf)")
+	flag.Parse()
+
+	if cfg.consumers < 1 {
+		fmt.Fprintln(os.Stderr, "number of consumers must be at least 1")
+		os.Exit(2)
+	}
+
+	mainResults(cfg)
+
+	if err := overallDurations(cfg); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	if err := stepsDurations(cfg); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
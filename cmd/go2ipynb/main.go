@@ -0,0 +1,142 @@
+/*
+Copyright © 2020 Pavel Tisnovsky
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Command go2ipynb exports literate sources as Jupyter notebooks that can
+// be run by the gophernotes kernel (or by the Python kernel for Python
+// sources). Each paragraph of prose becomes Markdown cell, each run of
+// code becomes code cell and expected output becomes output of the cell.
+// With -check, the notebook is converted back and compared with the
+// source.
+//
+// Usage:
+//
+//	go run ./cmd/go2ipynb gonum.go
+//	go run ./cmd/go2ipynb -o notebooks gonum.go gonum_output_as_comments.go
+//	go run ./cmd/go2ipynb -check gonum.go
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/tisnik/literate-programming-examples/notebook"
+)
+
+// notebookName returns name of notebook for given source.
+func notebookName(dir, filename string) string {
+	base := strings.TrimSuffix(filepath.Base(filename), filepath.Ext(filename)) + ".ipynb"
+	if dir == "" {
+		return filepath.Join(filepath.Dir(filename), base)
+	}
+	return filepath.Join(dir, base)
+}
+
+// roundTrip writes notebook, reads it again, converts it back and compares
+// the result with source.
+func roundTrip(filename string, src []byte, nb *notebook.Notebook) error {
+	var buffer bytes.Buffer
+	if err := notebook.Write(&buffer, nb); err != nil {
+		return err
+	}
+	nb, err := notebook.Read(&buffer)
+	if err != nil {
+		return err
+	}
+	result, err := notebook.Import(nb, notebook.Options{})
+	if err != nil {
+		return err
+	}
+	if bytes.Equal(result, src) {
+		return nil
+	}
+	expected := strings.Split(string(src), "\n")
+	actual := strings.Split(string(result), "\n")
+	for i := 0; i < len(expected) || i < len(actual); i++ {
+		var e, a string
+		if i < len(expected) {
+			e = expected[i]
+		}
+		if i < len(actual) {
+			a = actual[i]
+		}
+		if e != a {
+			return fmt.Errorf("%s:%d: notebook does not round-trip\n\tsource:   %q\n\tnotebook: %q", filename, i+1, e, a)
+		}
+	}
+	return fmt.Errorf("%s: notebook does not round-trip", filename)
+}
+
+// export writes notebook for one source.
+func export(filename, dir string, check bool) error {
+	src, err := os.ReadFile(filename)
+	if err != nil {
+		return err
+	}
+	nb, err := notebook.Export(filename, src)
+	if err != nil {
+		return err
+	}
+	if check {
+		return roundTrip(filename, src, nb)
+	}
+
+	output := notebookName(dir, filename)
+	f, err := os.Create(output)
+	if err != nil {
+		return err
+	}
+	if err := notebook.Write(f, nb); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	fmt.Printf("%s -> %s (%d cells)\n", filename, output, len(nb.Cells))
+	return nil
+}
+
+func main() {
+	dir := flag.String("o", "", "output directory (directory of source by default)")
+	check := flag.Bool("check", false, "only check that notebook can be converted back to the source")
+	flag.Parse()
+
+	if flag.NArg() == 0 {
+		fmt.Fprintln(os.Stderr, "usage: go2ipynb [-o dir] [-check] file...")
+		os.Exit(2)
+	}
+	if *dir != "" {
+		if err := os.MkdirAll(*dir, 0755); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+	}
+
+	failed := false
+	for _, filename := range flag.Args() {
+		if err := export(filename, *dir, *check); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			failed = true
+		}
+	}
+	if failed {
+		os.Exit(1)
+	}
+}
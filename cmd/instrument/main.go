@@ -0,0 +1,73 @@
+/*
+Copyright © 2020 Pavel Tisnovsky
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Command instrument rewrites literate Go source so that every value
+// printed by fmt.Print, fmt.Println, fmt.Printf (or fmt.Fprint* writing to
+// os.Stdout) is also emitted as a JSON record
+// (see package dump). Section names are taken from the nearest preceding
+// "// #" heading, source lines refer to the original file.
+//
+// Usage:
+//
+//	go run ./cmd/instrument -o build/gonum_dump.go gonum.go
+//	LITERATE_DUMP=gonum.jsonl go run build/gonum_dump.go
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/tisnik/literate-programming-examples/instrument"
+)
+
+func main() {
+	output := flag.String("o", "", "output file (standard output by default)")
+	flag.Parse()
+
+	if flag.NArg() != 1 {
+		fmt.Fprintln(os.Stderr, "usage: instrument [-o output.go] input.go")
+		os.Exit(2)
+	}
+	filename := flag.Arg(0)
+
+	src, err := os.ReadFile(filename)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	result, rewrites, err := instrument.Rewrite(filename, src)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	if *output == "" {
+		os.Stdout.Write(result)
+		return
+	}
+	if err := os.MkdirAll(filepath.Dir(*output), 0755); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	if err := os.WriteFile(*output, result, 0644); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	fmt.Fprintf(os.Stderr, "%d print statements instrumented in %s\n", rewrites, *output)
+}
@@ -0,0 +1,111 @@
+/*
+Copyright © 2020 Pavel Tisnovsky
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Command ipynb2go imports Jupyter notebooks back into literate sources.
+// Markdown cells become prose in comments and outputs stored in notebook
+// become expected outputs, written either in line comments (as in
+// gonum.go) or in block comments (as in gonum_output_as_comments.go).
+// Notebooks exported by go2ipynb are converted back to the original form,
+// cells of other notebooks are merged into one program with function
+// main. With -build, the result is compiled to check it.
+//
+// Usage:
+//
+//	go run ./cmd/ipynb2go notebooks/gonum.ipynb
+//	go run ./cmd/ipynb2go -o gonum.go -build notebooks/gonum.ipynb
+//	go run ./cmd/ipynb2go -style block analysis.ipynb
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/tisnik/literate-programming-examples/notebook"
+)
+
+// sourceName returns name of literate source for given notebook.
+func sourceName(nb *notebook.Notebook, filename string) string {
+	ext := ".go"
+	if nb.Metadata.LanguageInfo != nil && nb.Metadata.LanguageInfo.FileExtension != "" {
+		ext = nb.Metadata.LanguageInfo.FileExtension
+	}
+	return strings.TrimSuffix(filename, filepath.Ext(filename)) + ext
+}
+
+// build compiles given Go source.
+func build(filename string) error {
+	cmd := exec.Command("go", "build", "-o", os.DevNull, filename)
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("%s can not be compiled: %w", filename, err)
+	}
+	return nil
+}
+
+func main() {
+	output := flag.String("o", "", "output file (name of notebook with .go extension by default)")
+	style := flag.String("style", notebook.CommentStyle, "style of expected output (comment or block)")
+	check := flag.Bool("build", false, "compile the result")
+	flag.Parse()
+
+	if flag.NArg() != 1 {
+		fmt.Fprintln(os.Stderr, "usage: ipynb2go [-o file] [-style comment|block] [-build] notebook.ipynb")
+		os.Exit(2)
+	}
+	if *style != notebook.CommentStyle && *style != notebook.BlockStyle {
+		fmt.Fprintf(os.Stderr, "unknown style %q\n", *style)
+		os.Exit(2)
+	}
+
+	filename := flag.Arg(0)
+	f, err := os.Open(filename)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	nb, err := notebook.Read(f)
+	f.Close()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%s: %v\n", filename, err)
+		os.Exit(1)
+	}
+
+	src, err := notebook.Import(nb, notebook.Options{Style: *style})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%s: %v\n", filename, err)
+		os.Exit(1)
+	}
+	if *output == "" {
+		*output = sourceName(nb, filename)
+	}
+	if err := os.WriteFile(*output, src, 0644); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	fmt.Printf("%s -> %s\n", filename, *output)
+
+	if *check && strings.HasSuffix(*output, ".go") {
+		if err := build(*output); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+	}
+}
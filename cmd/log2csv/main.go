@@ -0,0 +1,172 @@
+/*
+Copyright © 2020 Pavel Tisnovsky
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Command log2csv transforms log written by the aggregator (with durations
+// printing enabled) into two CSV files analyzed by consumer_benchmarks.py
+// and by the consumerstats command. The log is processed as a stream, so
+// its size is not limited by available memory.
+//
+// Both JSON lines written by zerolog and logfmt style key=value lines are
+// recognized. Names of fields can be changed when the log format differs.
+//
+// Usage:
+//
+//	go run ./cmd/log2csv -o benchmark_results aggregator.log
+//	zcat aggregator.log.gz | go run ./cmd/log2csv -o benchmark_results
+//	go run ./cmd/log2csv -steps "Read=read,DB store=store" -total "" aggregator.log
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/tisnik/literate-programming-examples/consumer"
+)
+
+// parseSteps parses list of step=key pairs. Steps not mentioned in the
+// list are not expected in the log and are written as zeros.
+func parseSteps(value string) (map[string]string, error) {
+	keys := map[string]string{}
+	for _, pair := range strings.Split(value, ",") {
+		parts := strings.SplitN(pair, "=", 2)
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("invalid step mapping %q, expected step=key", pair)
+		}
+		step := strings.TrimSpace(parts[0])
+		known := false
+		for _, s := range consumer.Steps {
+			known = known || s == step
+		}
+		if !known {
+			return nil, fmt.Errorf("unknown step %q, available steps: %s",
+				step, strings.Join(consumer.Steps, ", "))
+		}
+		keys[step] = strings.TrimSpace(parts[1])
+	}
+	return keys, nil
+}
+
+// defaultSteps returns mapping used by default in the same format as
+// accepted by the -steps flag.
+func defaultSteps() string {
+	keys := consumer.DefaultLogFormat().StepKeys
+	pairs := make([]string, len(consumer.Steps))
+	for i, step := range consumer.Steps {
+		pairs[i] = step + "=" + keys[step]
+	}
+	return strings.Join(pairs, ",")
+}
+
+// openInput returns reader for all input files, or standard input when no
+// file is specified.
+func openInput(filenames []string) (io.Reader, func(), error) {
+	if len(filenames) == 0 {
+		return os.Stdin, func() {}, nil
+	}
+	var readers []io.Reader
+	var files []*os.File
+	closeAll := func() {
+		for _, f := range files {
+			f.Close()
+		}
+	}
+	for _, filename := range filenames {
+		f, err := os.Open(filename)
+		if err != nil {
+			closeAll()
+			return nil, nil, err
+		}
+		files = append(files, f)
+		readers = append(readers, f)
+	}
+	return io.MultiReader(readers...), closeAll, nil
+}
+
+// transform creates both CSV files in output directory.
+func transform(input io.Reader, dir string, format consumer.LogFormat, maxPending int) error {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+	durations, err := os.Create(filepath.Join(dir, consumer.DurationsFile))
+	if err != nil {
+		return err
+	}
+	defer durations.Close()
+
+	steps, err := os.Create(filepath.Join(dir, consumer.StepsDurationsFile))
+	if err != nil {
+		return err
+	}
+	defer steps.Close()
+
+	parser := consumer.NewLogParser(format)
+	parser.MaxPending = maxPending
+	summary, err := consumer.Transform(input, durations, steps, parser)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(os.Stderr, "lines:      %d\n", summary.Lines)
+	fmt.Fprintf(os.Stderr, "matched:    %d\n", summary.Matched)
+	fmt.Fprintf(os.Stderr, "messages:   %d\n", summary.Written)
+	if summary.Dropped > 0 || summary.Incomplete > 0 {
+		fmt.Fprintf(os.Stderr, "incomplete: %d (%d dropped)\n",
+			summary.Dropped+summary.Incomplete, summary.Dropped)
+	}
+
+	if err := durations.Close(); err != nil {
+		return err
+	}
+	return steps.Close()
+}
+
+func main() {
+	format := consumer.DefaultLogFormat()
+
+	dir := flag.String("o", ".", "output directory for CSV files")
+	stepsMapping := flag.String("steps", defaultSteps(), "comma separated list of step=key pairs")
+	maxPending := flag.Int("max-pending", 10000, "maximum number of incomplete messages kept in memory, 0 for no limit")
+	flag.StringVar(&format.OffsetKey, "offset", format.OffsetKey, "name of field with message offset")
+	flag.StringVar(&format.TotalKey, "total", format.TotalKey,
+		"name of field with whole duration, empty to compute it as sum of steps")
+	flag.DurationVar(&format.StepUnit, "step-unit", format.StepUnit, "unit of step durations written as plain numbers")
+	flag.DurationVar(&format.TotalUnit, "total-unit", format.TotalUnit, "unit of whole duration written as plain number")
+	flag.Parse()
+
+	keys, err := parseSteps(*stepsMapping)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+	format.StepKeys = keys
+
+	input, closeInput, err := openInput(flag.Args())
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	defer closeInput()
+
+	if err := transform(input, *dir, format, *maxPending); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		closeInput()
+		os.Exit(1)
+	}
+}
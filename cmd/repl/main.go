@@ -0,0 +1,301 @@
+/*
+Copyright © 2020 Pavel Tisnovsky
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Command repl walks literate Go source section by section. For each
+// section it shows the prose, runs code of all sections up to the current
+// one (so variables declared earlier remain available) and shows output of
+// the section. Code of the section can be edited and run again, edits can
+// be undone to get back to the state described in the document.
+//
+// Usage:
+//
+//	go run ./cmd/repl gonum.go
+//	go run ./cmd/repl -section 12 gonum.go
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+
+	"github.com/tisnik/literate-programming-examples/matexpr"
+	"github.com/tisnik/literate-programming-examples/repl"
+)
+
+const help = `Commands:
+  n, <enter>   next section
+  b            previous section
+  g N          go to section N
+  l            list sections
+  c            show code of the current section
+  r            run the current section again
+  p EXPR       print value of expression after the current section
+  m EXPR       print value of matrix expression (see package matexpr),
+               e.g. m m3' * m3 + m3
+  s/OLD/NEW/   replace text in code of the current section and run it
+  e            edit code of the current section in $EDITOR and run it
+  u            undo the last edit of the current section
+  reset        restore code of the current section from the document
+  h, ?         this help
+  q            quit
+`
+
+// ui is state of the interactive session.
+type ui struct {
+	session *repl.Session
+	current int
+	out     io.Writer
+}
+
+// indent prefixes all lines of text.
+func indent(text, prefix string) string {
+	lines := strings.Split(strings.TrimRight(text, "\n"), "\n")
+	for i, line := range lines {
+		lines[i] = prefix + line
+	}
+	return strings.Join(lines, "\n")
+}
+
+// show displays prose and code of the current section and runs it.
+func (u *ui) show() {
+	section := u.session.Sections[u.current]
+	fmt.Fprintf(u.out, "\n=== [%d/%d] %s\n", u.current, len(u.session.Sections)-1, section.Heading)
+	if section.Prose != "" {
+		fmt.Fprintf(u.out, "\n%s\n", section.Prose)
+	}
+	u.code()
+	u.run("")
+}
+
+// code displays code of the current section.
+func (u *ui) code() {
+	code := u.session.Code(u.current)
+	if strings.TrimSpace(code) == "" {
+		return
+	}
+	title := "Code"
+	if u.session.Edited(u.current) {
+		title = "Code (edited)"
+	}
+	fmt.Fprintf(u.out, "\n--- %s:\n%s\n", title, indent(code, "    "))
+}
+
+// run runs program up to the current section and displays output of the
+// section together with expected output when it differs.
+func (u *ui) run(extra string) {
+	if extra == "" && (!u.session.Runnable(u.current) || strings.TrimSpace(u.session.Code(u.current)) == "") {
+		return
+	}
+	result, err := u.session.Run(u.current, extra)
+	if err != nil {
+		fmt.Fprintf(u.out, "\n--- Error:\n%s\n", indent(err.Error(), "    "))
+		return
+	}
+	output := result.Output(u.current)
+	if extra != "" {
+		output = result.Output(repl.Extra)
+	}
+	if output != "" {
+		fmt.Fprintf(u.out, "\n--- Output:\n%s\n", indent(output, "    "))
+	}
+	if result.Err != nil {
+		fmt.Fprintf(u.out, "\n--- Error (%v):\n%s\n", result.Err, indent(result.Stderr, "    "))
+		return
+	}
+
+	expected := strings.Join(u.session.Sections[u.current].Expected, "\n")
+	if extra == "" && expected != "" && strings.TrimSpace(expected) != strings.TrimSpace(output) {
+		fmt.Fprintf(u.out, "\n--- Expected output (from document):\n%s\n", indent(expected, "    "))
+	}
+}
+
+// list displays headings of all sections.
+func (u *ui) list() {
+	heading := ""
+	for _, section := range u.session.Sections {
+		if section.Heading == heading {
+			continue
+		}
+		heading = section.Heading
+		mark := " "
+		if section.Index <= u.current {
+			mark = "*"
+		}
+		fmt.Fprintf(u.out, "%s %3d  %s\n", mark, section.Index, heading)
+	}
+}
+
+// edit opens code of the current section in editor.
+func (u *ui) edit() error {
+	editor := os.Getenv("EDITOR")
+	if editor == "" {
+		return fmt.Errorf("EDITOR is not set, use s/OLD/NEW/ instead")
+	}
+	f, err := os.CreateTemp("", "section-*.go")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+	if _, err := f.WriteString(u.session.Code(u.current) + "\n"); err != nil {
+		f.Close()
+		return err
+	}
+	f.Close()
+
+	cmd := exec.Command(editor, f.Name())
+	cmd.Stdin, cmd.Stdout, cmd.Stderr = os.Stdin, os.Stdout, os.Stderr
+	if err := cmd.Run(); err != nil {
+		return err
+	}
+	code, err := os.ReadFile(f.Name())
+	if err != nil {
+		return err
+	}
+	u.session.Edit(u.current, string(code))
+	return nil
+}
+
+// substitute handles command s/OLD/NEW/, any character can be used as
+// separator.
+func (u *ui) substitute(command string) error {
+	separator := command[1:2]
+	parts := strings.Split(command[2:], separator)
+	if len(parts) < 2 || parts[0] == "" {
+		return fmt.Errorf("usage: s/OLD/NEW/")
+	}
+	return u.session.Replace(u.current, parts[0], parts[1])
+}
+
+// move changes the current section.
+func (u *ui) move(index int) {
+	if index < 0 || index >= len(u.session.Sections) {
+		fmt.Fprintf(u.out, "no section %d\n", index)
+		return
+	}
+	u.current = index
+	u.show()
+}
+
+// matrixExpression returns code that prints value of matrix expression,
+// variables used in the expression are passed to it from the program.
+func matrixExpression(expr string) (string, error) {
+	parsed, err := matexpr.Parse(expr)
+	if err != nil {
+		return "", err
+	}
+	var vars []string
+	for _, name := range parsed.Variables() {
+		vars = append(vars, fmt.Sprintf("%q: %s", name, name))
+	}
+	return fmt.Sprintf("fmt.Println(matexpr.Format(matexpr.MustEval(%q, matexpr.Vars{%s})))",
+		expr, strings.Join(vars, ", ")), nil
+}
+
+// execute performs one command, it returns false to quit.
+func (u *ui) execute(command string) bool {
+	command = strings.TrimSpace(command)
+	var err error
+	switch {
+	case command == "" || command == "n":
+		u.move(u.current + 1)
+	case command == "b":
+		u.move(u.current - 1)
+	case strings.HasPrefix(command, "g "):
+		var index int
+		index, err = strconv.Atoi(strings.TrimSpace(command[2:]))
+		if err == nil {
+			u.move(index)
+		}
+	case command == "l":
+		u.list()
+	case command == "c":
+		u.code()
+	case command == "r":
+		u.run("")
+	case strings.HasPrefix(command, "p "):
+		u.run("fmt.Println(" + strings.TrimSpace(command[2:]) + ")")
+	case strings.HasPrefix(command, "m "):
+		var code string
+		if code, err = matrixExpression(strings.TrimSpace(command[2:])); err == nil {
+			u.run(code)
+		}
+	case strings.HasPrefix(command, "s") && len(command) > 2 && !strings.ContainsAny(command[1:2], " \t"):
+		if err = u.substitute(command); err == nil {
+			u.code()
+			u.run("")
+		}
+	case command == "e":
+		if err = u.edit(); err == nil {
+			u.code()
+			u.run("")
+		}
+	case command == "u":
+		if u.session.Undo(u.current) {
+			u.code()
+			u.run("")
+		} else {
+			fmt.Fprintln(u.out, "nothing to undo")
+		}
+	case command == "reset":
+		u.session.Reset(u.current)
+		u.code()
+		u.run("")
+	case command == "h" || command == "?":
+		fmt.Fprint(u.out, help)
+	case command == "q":
+		return false
+	default:
+		fmt.Fprintf(u.out, "unknown command %q, type h for help\n", command)
+	}
+	if err != nil {
+		fmt.Fprintln(u.out, err)
+	}
+	return true
+}
+
+func main() {
+	start := flag.Int("section", 0, "section to start with")
+	buildDir := flag.String("build", "build", "directory for assembled programs, has to be inside the module")
+	flag.Parse()
+
+	if flag.NArg() != 1 {
+		fmt.Fprintln(os.Stderr, "usage: repl [-section N] [-build dir] file.go")
+		os.Exit(2)
+	}
+	session, err := repl.NewSession(flag.Arg(0), *buildDir)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	u := ui{session: session, out: os.Stdout}
+	fmt.Fprintf(u.out, "%s: %d sections, type h for help\n", flag.Arg(0), len(session.Sections))
+	u.move(*start)
+
+	input := bufio.NewScanner(os.Stdin)
+	for {
+		fmt.Fprintf(u.out, "\n[%d]> ", u.current)
+		if !input.Scan() || !u.execute(input.Text()) {
+			fmt.Fprintln(u.out)
+			return
+		}
+	}
+}
@@ -0,0 +1,273 @@
+/*
+Copyright © 2020 Pavel Tisnovsky
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Command weave converts literate Go and Python sources into HTML pages
+// with prose next to the code. It replaces docgo and pycco used to
+// generate pages in docs/ previously, so all pages share one style sheet.
+//
+// Name of generated page is derived from name of source file, it can be
+// specified explicitly after equal sign. With -index flag the index page
+// listing all generated pages (with their table of contents) and all other
+// HTML pages found in output directory is generated too, as well as search
+// index used by the search box available on all pages. Both indexes cover
+// just pages given on command line, so -index should be used only when all
+// pages are regenerated (see make docs).
+//
+// With -run flag Go sources are instrumented (see package instrument) and
+// executed, real output is displayed next to the code that printed it and
+// expected outputs that differ from real output are highlighted.
+//
+// Usage:
+//
+//	go run ./cmd/weave -index -run -o docs gonum.go=gonum_std.html gonum_spy.go consumer_benchmarks.py
+//	go run ./cmd/weave -run -o docs gonum_output_as_comments.go
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/tisnik/literate-programming-examples/literate"
+	"github.com/tisnik/literate-programming-examples/weave"
+)
+
+// target is one source file and the page generated from it.
+type target struct {
+	source string
+	page   string
+}
+
+// parseTarget parses argument in form source[=page].
+func parseTarget(arg string) target {
+	if i := strings.Index(arg, "="); i >= 0 {
+		return target{source: arg[:i], page: arg[i+1:]}
+	}
+	base := filepath.Base(arg)
+	return target{
+		source: arg,
+		page:   strings.TrimSuffix(base, filepath.Ext(base)) + ".html",
+	}
+}
+
+// configuration contains all command line options.
+type configuration struct {
+	dir      string
+	title    string
+	index    bool
+	run      bool
+	buildDir string
+	lang     string
+}
+
+// weaveFile generates one page.
+func weaveFile(t target, cfg configuration) (*weave.Page, error) {
+	doc, err := literate.ParseFile(t.source)
+	if err != nil {
+		return nil, err
+	}
+	page, err := weave.Weave(doc, cfg.lang)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", t.source, err)
+	}
+
+	if cfg.run && doc.Language == literate.Go {
+		run, err := weave.Execute(t.source, cfg.buildDir, cfg.dir)
+		if err != nil {
+			return nil, err
+		}
+		page.Annotate(run, literate.DefaultTolerance)
+		reportDifferences(page)
+	}
+
+	f, err := os.Create(filepath.Join(cfg.dir, t.page))
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	if err := weave.Render(f, page); err != nil {
+		return nil, fmt.Errorf("%s: %w", t.page, err)
+	}
+	return page, f.Close()
+}
+
+// reportDifferences prints expected outputs that differ from real output.
+func reportDifferences(page *weave.Page) {
+	for _, section := range page.Sections {
+		for _, item := range section.Items {
+			if item.Checked && item.Result != literate.Match {
+				fmt.Fprintf(os.Stderr, "%s:%s\n", page.Source, item.Note)
+			}
+		}
+	}
+}
+
+// lastChange returns date of the last commit that changed given file, or
+// its modification time when the file is not tracked by git.
+func lastChange(filename string) string {
+	out, err := exec.Command("git", "log", "-1", "--format=%cs", "--", filename).Output()
+	if date := strings.TrimSpace(string(out)); err == nil && date != "" {
+		return date
+	}
+	if info, err := os.Stat(filename); err == nil {
+		return info.ModTime().Format("2006-01-02")
+	}
+	return ""
+}
+
+// titleRegexp matches title of HTML page.
+var titleRegexp = regexp.MustCompile(`(?is)<title>\s*(.*?)\s*</title>`)
+
+// otherPages returns entries for HTML pages found in output directory that
+// were not generated from literate sources.
+func otherPages(dir string, generated map[string]bool) ([]weave.Entry, error) {
+	filenames, err := filepath.Glob(filepath.Join(dir, "*.html"))
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(filenames)
+
+	var others []weave.Entry
+	for _, filename := range filenames {
+		name := filepath.Base(filename)
+		if generated[name] || name == indexPage {
+			continue
+		}
+		content, err := os.ReadFile(filename)
+		if err != nil {
+			return nil, err
+		}
+		title := name
+		if match := titleRegexp.FindSubmatch(content); match != nil {
+			title = string(match[1])
+		}
+		others = append(others, weave.Entry{Title: title, Page: name})
+	}
+	return others, nil
+}
+
+// indexPage is name of generated index page.
+const indexPage = "index.html"
+
+// writeIndex generates index page.
+func writeIndex(dir string, title string, entries []weave.Entry) error {
+	generated := map[string]bool{}
+	for _, entry := range entries {
+		generated[entry.Page] = true
+	}
+	others, err := otherPages(dir, generated)
+	if err != nil {
+		return err
+	}
+
+	f, err := os.Create(filepath.Join(dir, indexPage))
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	err = weave.RenderIndex(f, &weave.Index{
+		Title:      title,
+		StyleSheet: weave.StyleSheet,
+		Generated:  time.Now().UTC(),
+		Entries:    entries,
+		Others:     others,
+	})
+	if err != nil {
+		return err
+	}
+	return f.Close()
+}
+
+// writeSearchIndex stores search index into output directory.
+func writeSearchIndex(dir string, search *weave.SearchIndex) error {
+	f, err := os.Create(filepath.Join(dir, weave.SearchIndexFile))
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	if err := search.Write(f); err != nil {
+		return err
+	}
+	return f.Close()
+}
+
+func main() {
+	var cfg configuration
+	flag.StringVar(&cfg.dir, "o", "docs", "output directory")
+	flag.StringVar(&cfg.title, "title", "Literate programming examples", "title of index page")
+	flag.BoolVar(&cfg.index, "index", false, "generate index page (all pages have to be given)")
+	flag.BoolVar(&cfg.run, "run", false, "run Go sources and display their real output")
+	flag.StringVar(&cfg.buildDir, "build", "build", "directory for instrumented sources, has to be inside the module")
+	flag.StringVar(&cfg.lang, "lang", "cs", "language of prose without language marker")
+	flag.Parse()
+
+	if flag.NArg() == 0 {
+		fmt.Fprintln(os.Stderr, "usage: weave [-o dir] [-run] source[=page.html]...")
+		os.Exit(2)
+	}
+	if err := os.MkdirAll(cfg.dir, 0o755); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	static := map[string][]byte{
+		weave.StyleSheet:       weave.CSS,
+		weave.SearchScriptFile: weave.SearchScript,
+	}
+	for name, content := range static {
+		err := os.WriteFile(filepath.Join(cfg.dir, name), content, 0o644)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+	}
+
+	var entries []weave.Entry
+	search := weave.NewSearchIndex()
+	for _, arg := range flag.Args() {
+		t := parseTarget(arg)
+		page, err := weaveFile(t, cfg)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		entries = append(entries, weave.NewEntry(page, t.page, lastChange(t.source)))
+		search.Add(page, t.page)
+		fmt.Fprintf(os.Stderr, "%s -> %s\n", t.source, filepath.Join(cfg.dir, t.page))
+	}
+
+	// search index covers only pages given on command line, so it is
+	// regenerated together with the index page
+	if !cfg.index {
+		return
+	}
+	if err := writeIndex(cfg.dir, cfg.title, entries); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	if err := writeSearchIndex(cfg.dir, search); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,372 @@
+/*
+Copyright © 2020 Pavel Tisnovsky
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package consumer
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// LogFormat describes which fields of aggregator log lines contain the
+// message offset and the measured durations.
+type LogFormat struct {
+	// OffsetKey is name of field with message offset.
+	OffsetKey string
+	// TotalKey is name of field with duration of whole consume operation.
+	// When empty, the total duration is computed as sum of all steps.
+	TotalKey string
+	// StepKeys maps names of steps (see Steps) to names of log fields.
+	StepKeys map[string]string
+	// StepUnit and TotalUnit are used for values written as plain
+	// numbers, values with units (e.g. "1.5ms") are parsed directly.
+	StepUnit  time.Duration
+	TotalUnit time.Duration
+}
+
+// DefaultLogFormat returns format of logs written by the aggregator when
+// durations printing is enabled.
+func DefaultLogFormat() LogFormat {
+	return LogFormat{
+		OffsetKey: "offset",
+		TotalKey:  "duration",
+		StepKeys: map[string]string{
+			"Read":         "read",
+			"Whitelisting": "whitelisting",
+			"Marshalling":  "marshalling",
+			"Time check":   "time_check",
+			"DB store":     "db_store",
+		},
+		StepUnit:  time.Nanosecond,
+		TotalUnit: time.Millisecond,
+	}
+}
+
+// Measurement contains all durations measured for one message.
+type Measurement struct {
+	Offset int64
+	Total  time.Duration
+	// Steps are stored in the same order as names in Steps.
+	Steps []time.Duration
+}
+
+// partial is measurement that has not been completed yet.
+type partial struct {
+	steps    []time.Duration
+	seen     []bool
+	total    time.Duration
+	hasTotal bool
+}
+
+// LogParser parses log lines one by one and returns measurements as soon
+// as all values for given message offset are found. Values for one message
+// can be spread over several lines. Only incomplete measurements are kept
+// in memory, so even very large logs can be processed as a stream.
+type LogParser struct {
+	format LogFormat
+	keys   map[string]int
+
+	pending map[int64]*partial
+	order   []int64
+
+	// MaxPending limits number of incomplete measurements kept in
+	// memory. When the limit is reached, the oldest one is dropped. Zero
+	// or negative value means no limit.
+	MaxPending int
+	// Dropped is number of measurements dropped because of MaxPending.
+	Dropped int
+	// Lines and Matched count all parsed lines and lines with offset.
+	Lines   int
+	Matched int
+}
+
+// NewLogParser constructs parser for given log format.
+func NewLogParser(format LogFormat) *LogParser {
+	keys := map[string]int{}
+	for i, step := range Steps {
+		if key, found := format.StepKeys[step]; found {
+			keys[key] = i
+		}
+	}
+	return &LogParser{
+		format:     format,
+		keys:       keys,
+		pending:    map[int64]*partial{},
+		MaxPending: 10000,
+	}
+}
+
+// keyValueRegexp matches key=value and key="quoted value" pairs.
+var keyValueRegexp = regexp.MustCompile(`([A-Za-z_][\w.-]*)=("(?:[^"\\]|\\.)*"|\S*)`)
+
+// ParseFields extracts fields from one log line. Both JSON lines (as
+// written by zerolog) and logfmt style key=value pairs are supported. Text
+// before the JSON object, typically timestamp, is ignored.
+func ParseFields(line string) map[string]string {
+	fields := map[string]string{}
+
+	if start := strings.IndexByte(line, '{'); start >= 0 {
+		decoder := json.NewDecoder(strings.NewReader(line[start:]))
+		decoder.UseNumber()
+		var object map[string]interface{}
+		if err := decoder.Decode(&object); err == nil {
+			for key, value := range object {
+				fields[key] = fmt.Sprint(value)
+			}
+			return fields
+		}
+	}
+
+	for _, match := range keyValueRegexp.FindAllStringSubmatch(line, -1) {
+		value := match[2]
+		if unquoted, err := strconv.Unquote(value); err == nil {
+			value = unquoted
+		}
+		fields[match[1]] = value
+	}
+	return fields
+}
+
+// parseDuration parses duration with unit ("12.5ms") or plain number
+// in given unit.
+func parseDuration(value string, unit time.Duration) (time.Duration, error) {
+	if number, err := strconv.ParseFloat(value, 64); err == nil {
+		return time.Duration(number * float64(unit)), nil
+	}
+	return time.ParseDuration(value)
+}
+
+// ParseLine processes one log line and returns measurements completed by
+// this line (usually zero or one).
+func (p *LogParser) ParseLine(line string) ([]Measurement, error) {
+	p.Lines++
+	fields := ParseFields(line)
+	offsetValue, found := fields[p.format.OffsetKey]
+	if !found {
+		return nil, nil
+	}
+	offset, err := strconv.ParseInt(offsetValue, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("line %d: invalid offset %q", p.Lines, offsetValue)
+	}
+
+	m, err := p.update(offset, fields)
+	if err != nil || m == nil {
+		return nil, err
+	}
+	return []Measurement{*m}, nil
+}
+
+// update stores durations found in fields and returns measurement when
+// it is complete.
+func (p *LogParser) update(offset int64, fields map[string]string) (*Measurement, error) {
+	part := p.pending[offset]
+	changed := false
+
+	for key, value := range fields {
+		index, isStep := p.keys[key]
+		isTotal := p.format.TotalKey != "" && key == p.format.TotalKey
+		if !isStep && !isTotal {
+			continue
+		}
+		if part == nil {
+			part = &partial{
+				steps: make([]time.Duration, len(Steps)),
+				seen:  make([]bool, len(Steps)),
+			}
+		}
+		changed = true
+
+		if isTotal {
+			d, err := parseDuration(value, p.format.TotalUnit)
+			if err != nil {
+				return nil, fmt.Errorf("line %d: %s: %w", p.Lines, key, err)
+			}
+			part.total = d
+			part.hasTotal = true
+			continue
+		}
+		d, err := parseDuration(value, p.format.StepUnit)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %s: %w", p.Lines, key, err)
+		}
+		part.steps[index] = d
+		part.seen[index] = true
+	}
+	if !changed {
+		return nil, nil
+	}
+	p.Matched++
+
+	if !p.complete(part) {
+		if _, found := p.pending[offset]; !found {
+			p.pending[offset] = part
+			// order is needed only to find the oldest measurements
+			if p.MaxPending > 0 {
+				p.order = append(p.order, offset)
+				p.limit()
+			}
+		}
+		return nil, nil
+	}
+
+	delete(p.pending, offset)
+	m := &Measurement{Offset: offset, Total: part.total, Steps: part.steps}
+	if !part.hasTotal {
+		for _, d := range part.steps {
+			m.Total += d
+		}
+	}
+	return m, nil
+}
+
+// complete checks whether all required values were found.
+func (p *LogParser) complete(part *partial) bool {
+	for i, step := range Steps {
+		if _, configured := p.format.StepKeys[step]; configured && !part.seen[i] {
+			return false
+		}
+	}
+	return p.format.TotalKey == "" || part.hasTotal
+}
+
+// limit drops the oldest incomplete measurements when there are too many
+// of them. Offsets of already completed measurements are removed from the
+// queue lazily.
+func (p *LogParser) limit() {
+	for len(p.pending) > p.MaxPending && len(p.order) > 0 {
+		oldest := p.order[0]
+		p.order = p.order[1:]
+		if _, found := p.pending[oldest]; found {
+			delete(p.pending, oldest)
+			p.Dropped++
+		}
+	}
+	// compact the queue so it does not grow without limits
+	if len(p.order) > 2*p.MaxPending+16 {
+		order := p.order[:0]
+		for _, offset := range p.order {
+			if _, found := p.pending[offset]; found {
+				order = append(order, offset)
+			}
+		}
+		p.order = order
+	}
+}
+
+// Incomplete returns number of measurements that are still incomplete.
+func (p *LogParser) Incomplete() int {
+	return len(p.pending)
+}
+
+// FormatMilliseconds formats duration as number of milliseconds, the unit
+// used in consumer_durations.csv.
+func FormatMilliseconds(d time.Duration) string {
+	return strconv.FormatFloat(float64(d)/float64(time.Millisecond), 'f', -1, 64)
+}
+
+// FormatNanoseconds formats duration as number of nanoseconds, the unit
+// used in consumer_steps_durations.csv.
+func FormatNanoseconds(d time.Duration) string {
+	return strconv.FormatInt(int64(d), 10)
+}
+
+// TransformSummary contains information about transformed log.
+type TransformSummary struct {
+	Lines      int
+	Matched    int
+	Written    int
+	Dropped    int
+	Incomplete int
+}
+
+// Transform reads log from r line by line and writes both CSV files used by
+// the benchmark analysis: durations (Duration in ms, Offset) and steps
+// durations (one column per step in ns). Rows are written as soon as
+// measurement for given message is complete, in order of completion, so
+// memory consumption does not depend on log size.
+func Transform(r io.Reader, durations, steps io.Writer, parser *LogParser) (TransformSummary, error) {
+	var summary TransformSummary
+
+	durationsWriter := csv.NewWriter(durations)
+	stepsWriter := csv.NewWriter(steps)
+
+	if err := durationsWriter.Write([]string{DurationColumn, OffsetColumn}); err != nil {
+		return summary, err
+	}
+	if err := stepsWriter.Write(Steps); err != nil {
+		return summary, err
+	}
+
+	scanner := bufio.NewScanner(r)
+	// log lines with long messages can exceed default 64 kB limit
+	scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+
+	record := make([]string, len(Steps))
+	first := true
+	for scanner.Scan() {
+		line := scanner.Bytes()
+		if first {
+			line = bytes.TrimPrefix(line, []byte("\xef\xbb\xbf"))
+			first = false
+		}
+		measurements, err := parser.ParseLine(string(line))
+		if err != nil {
+			return summary, err
+		}
+		for _, m := range measurements {
+			err := durationsWriter.Write([]string{
+				FormatMilliseconds(m.Total),
+				strconv.FormatInt(m.Offset, 10)})
+			if err != nil {
+				return summary, err
+			}
+			for i, d := range m.Steps {
+				record[i] = FormatNanoseconds(d)
+			}
+			if err := stepsWriter.Write(record); err != nil {
+				return summary, err
+			}
+			summary.Written++
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return summary, err
+	}
+
+	durationsWriter.Flush()
+	stepsWriter.Flush()
+	if err := durationsWriter.Error(); err != nil {
+		return summary, err
+	}
+	if err := stepsWriter.Error(); err != nil {
+		return summary, err
+	}
+
+	summary.Lines = parser.Lines
+	summary.Matched = parser.Matched
+	summary.Dropped = parser.Dropped
+	summary.Incomplete = parser.Incomplete()
+	return summary, nil
+}
@@ -0,0 +1,235 @@
+/*
+Copyright © 2020 Pavel Tisnovsky
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package consumer
+
+import (
+	"bytes"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseFields(t *testing.T) {
+	tests := []struct {
+		name string
+		line string
+		want map[string]string
+	}{
+		{
+			name: "JSON",
+			line: `{"level":"info","offset":42,"read":1500,"message":"Read"}`,
+			want: map[string]string{"level": "info", "offset": "42", "read": "1500", "message": "Read"},
+		},
+		{
+			name: "JSON with timestamp",
+			line: `2020-06-18 10:00:00 {"offset":7,"duration":1.25}`,
+			want: map[string]string{"offset": "7", "duration": "1.25"},
+		},
+		{
+			name: "logfmt",
+			line: `level=info offset=42 db_store=3ms message="Stored message"`,
+			want: map[string]string{"level": "info", "offset": "42", "db_store": "3ms", "message": "Stored message"},
+		},
+		{
+			name: "invalid JSON read as logfmt",
+			line: `{broken offset=1`,
+			want: map[string]string{"offset": "1"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ParseFields(tt.line); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseFields(%q) = %v, want %v", tt.line, got, tt.want)
+			}
+		})
+	}
+}
+
+// parseAll parses all lines and returns completed measurements.
+func parseAll(t *testing.T, p *LogParser, lines []string) []Measurement {
+	t.Helper()
+	var result []Measurement
+	for _, line := range lines {
+		m, err := p.ParseLine(line)
+		if err != nil {
+			t.Fatal(err)
+		}
+		result = append(result, m...)
+	}
+	return result
+}
+
+func TestLogParserMultiLine(t *testing.T) {
+	steps := []time.Duration{1000, 2000, 3000, 4000, 5000}
+	tests := []struct {
+		name  string
+		lines []string
+	}{
+		{
+			name: "JSON",
+			lines: []string{
+				`{"offset":1,"read":1000,"whitelisting":2000}`,
+				`{"offset":1,"marshalling":3000}`,
+				`{"level":"debug","message":"unrelated"}`,
+				`{"offset":1,"time_check":4000,"db_store":5000}`,
+				`{"offset":1,"duration":0.015}`,
+			},
+		},
+		{
+			name: "logfmt",
+			lines: []string{
+				`offset=1 read=1µs`,
+				`offset=1 whitelisting=2000 marshalling=3000`,
+				`offset=1 time_check=4000 db_store=5µs duration=15µs`,
+			},
+		},
+		{
+			name: "mixed",
+			lines: []string{
+				`offset=1 read=1000 whitelisting=2000 marshalling=3000`,
+				`2020-06-18T10:00:00Z {"offset":1,"time_check":4000,"db_store":5000,"duration":"15µs"}`,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := NewLogParser(DefaultLogFormat())
+			got := parseAll(t, p, tt.lines)
+			want := []Measurement{{Offset: 1, Total: 15 * time.Microsecond, Steps: steps}}
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("measurements = %v, want %v", got, want)
+			}
+			if p.Incomplete() != 0 {
+				t.Errorf("%d incomplete measurements left", p.Incomplete())
+			}
+		})
+	}
+}
+
+func TestLogParserInterleaved(t *testing.T) {
+	format := DefaultLogFormat()
+	format.TotalKey = ""
+	p := NewLogParser(format)
+	got := parseAll(t, p, []string{
+		`offset=1 read=1 whitelisting=1`,
+		`offset=2 read=2 whitelisting=2 marshalling=2`,
+		`offset=1 marshalling=1 time_check=1`,
+		`offset=2 time_check=2 db_store=2`,
+		`offset=1 db_store=1`,
+	})
+	want := []Measurement{
+		{Offset: 2, Total: 10, Steps: []time.Duration{2, 2, 2, 2, 2}},
+		{Offset: 1, Total: 5, Steps: []time.Duration{1, 1, 1, 1, 1}},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("measurements = %v, want %v", got, want)
+	}
+	if p.Lines != 5 || p.Matched != 5 {
+		t.Errorf("lines = %d, matched = %d, want 5 and 5", p.Lines, p.Matched)
+	}
+}
+
+func TestLogParserMaxPending(t *testing.T) {
+	p := NewLogParser(DefaultLogFormat())
+	p.MaxPending = 2
+	// three incomplete measurements, the oldest one is dropped
+	parseAll(t, p, []string{
+		`offset=1 read=1`,
+		`offset=2 read=2`,
+		`offset=2 whitelisting=2`,
+		`offset=3 read=3`,
+	})
+	if p.Dropped != 1 || p.Incomplete() != 2 {
+		t.Fatalf("dropped = %d, incomplete = %d, want 1 and 2", p.Dropped, p.Incomplete())
+	}
+
+	// the rest of dropped measurement does not complete it, it starts new
+	// incomplete measurement that pushes out the oldest one (offset 2)
+	got := parseAll(t, p, []string{
+		`offset=1 whitelisting=1 marshalling=1 time_check=1 db_store=1 duration=5`,
+		`offset=3 whitelisting=3 marshalling=3 time_check=3 db_store=3 duration=15`,
+	})
+	if len(got) != 1 || got[0].Offset != 3 {
+		t.Errorf("measurements = %v, want only offset 3", got)
+	}
+	if p.Dropped != 2 || p.Incomplete() != 1 {
+		t.Errorf("dropped = %d, incomplete = %d, want 2 and 1", p.Dropped, p.Incomplete())
+	}
+}
+
+func TestLogParserNoLimit(t *testing.T) {
+	for _, maxPending := range []int{0, -1} {
+		p := NewLogParser(DefaultLogFormat())
+		p.MaxPending = maxPending
+		got := parseAll(t, p, []string{
+			`offset=1 read=1`,
+			`offset=2 read=2`,
+			`offset=3 read=3`,
+			`offset=1 whitelisting=1 marshalling=1 time_check=1 db_store=1 duration=5`,
+		})
+		if len(got) != 1 || got[0].Offset != 1 {
+			t.Errorf("MaxPending = %d: measurements = %v, want offset 1", maxPending, got)
+		}
+		if p.Dropped != 0 || p.Incomplete() != 2 {
+			t.Errorf("MaxPending = %d: dropped = %d, incomplete = %d, want 0 and 2",
+				maxPending, p.Dropped, p.Incomplete())
+		}
+	}
+}
+
+func TestLogParserMaxPendingQueue(t *testing.T) {
+	p := NewLogParser(DefaultLogFormat())
+	p.MaxPending = 4
+	// completed measurements must not stay in the queue forever
+	for i := 0; i < 1000; i++ {
+		parseAll(t, p, []string{
+			"offset=" + strconv.Itoa(i) + " read=1",
+			"offset=" + strconv.Itoa(i) + " whitelisting=1 marshalling=1 time_check=1 db_store=1 duration=1",
+		})
+	}
+	if p.Dropped != 0 || p.Incomplete() != 0 {
+		t.Errorf("dropped = %d, incomplete = %d, want 0 and 0", p.Dropped, p.Incomplete())
+	}
+	if len(p.order) > 2*p.MaxPending+16 {
+		t.Errorf("queue contains %d offsets", len(p.order))
+	}
+}
+
+func TestTransform(t *testing.T) {
+	log := "\xef\xbb\xbf" + strings.Join([]string{
+		`{"offset":10,"read":1000,"whitelisting":2000,"marshalling":3000}`,
+		`{"offset":10,"time_check":4000,"db_store":5000,"duration":0.5}`,
+		`{"offset":11,"read":1000}`,
+	}, "\n")
+	var durations, steps bytes.Buffer
+	summary, err := Transform(strings.NewReader(log), &durations, &steps, NewLogParser(DefaultLogFormat()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := TransformSummary{Lines: 3, Matched: 3, Written: 1, Incomplete: 1}
+	if summary != want {
+		t.Errorf("summary = %+v, want %+v", summary, want)
+	}
+	if got := durations.String(); got != "Duration,Offset\n0.5,10\n" {
+		t.Errorf("durations = %q", got)
+	}
+	if got := steps.String(); got != "Read,Whitelisting,Marshalling,Time check,DB store\n1000,2000,3000,4000,5000\n" {
+		t.Errorf("steps = %q", got)
+	}
+}
@@ -0,0 +1,150 @@
+/*
+Copyright © 2020 Pavel Tisnovsky
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package consumer
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"text/tabwriter"
+)
+
+// Percentiles reported by Describe in addition to the quartiles.
+var Percentiles = []float64{90, 95, 99}
+
+// Stats contains the same statistic as DataFrame.describe() in pandas,
+// extended by selected percentiles.
+type Stats struct {
+	Count       int
+	Mean        float64
+	Std         float64
+	Min         float64
+	Q1          float64
+	Median      float64
+	Q3          float64
+	Max         float64
+	Percentiles []float64
+}
+
+// Percentile computes p-th percentile (0..100) of sorted values using
+// linear interpolation between closest ranks, i.e. the same method as
+// pandas and NumPy use by default.
+func Percentile(sorted []float64, p float64) float64 {
+	if len(sorted) == 0 {
+		return math.NaN()
+	}
+	rank := p / 100 * float64(len(sorted)-1)
+	lower := int(math.Floor(rank))
+	upper := int(math.Ceil(rank))
+	if lower == upper {
+		return sorted[lower]
+	}
+	return sorted[lower] + (rank-float64(lower))*(sorted[upper]-sorted[lower])
+}
+
+// Describe computes statistic for given values. The input slice is not
+// modified.
+func Describe(values []float64) Stats {
+	s := Stats{Count: len(values)}
+	if len(values) == 0 {
+		return s
+	}
+
+	sorted := append([]float64(nil), values...)
+	sort.Float64s(sorted)
+
+	sum := 0.0
+	for _, v := range sorted {
+		sum += v
+	}
+	s.Mean = sum / float64(len(sorted))
+
+	// sample standard deviation (ddof=1) as in pandas
+	if len(sorted) > 1 {
+		variance := 0.0
+		for _, v := range sorted {
+			variance += (v - s.Mean) * (v - s.Mean)
+		}
+		s.Std = math.Sqrt(variance / float64(len(sorted)-1))
+	} else {
+		s.Std = math.NaN()
+	}
+
+	s.Min = sorted[0]
+	s.Q1 = Percentile(sorted, 25)
+	s.Median = Percentile(sorted, 50)
+	s.Q3 = Percentile(sorted, 75)
+	s.Max = sorted[len(sorted)-1]
+	for _, p := range Percentiles {
+		s.Percentiles = append(s.Percentiles, Percentile(sorted, p))
+	}
+	return s
+}
+
+// PerSecond converts duration of one operation to number of operations
+// per second. Unit is the number of given duration units in one second,
+// for example 1e3 for milliseconds.
+func PerSecond(duration, unit float64) float64 {
+	if duration == 0 {
+		return math.Inf(1)
+	}
+	return unit / duration
+}
+
+// WriteStats writes statistic for several named columns as a table with
+// one column per name, similarly to DataFrame.describe().
+func WriteStats(w io.Writer, names []string, stats []Stats) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', tabwriter.AlignRight)
+
+	row := func(label string, value func(Stats) string) {
+		fmt.Fprintf(tw, "%s\t", label)
+		for _, s := range stats {
+			fmt.Fprintf(tw, "%s\t", value(s))
+		}
+		fmt.Fprintln(tw)
+	}
+	number := func(f func(Stats) float64) func(Stats) string {
+		return func(s Stats) string {
+			return fmt.Sprintf("%.3f", f(s))
+		}
+	}
+
+	fmt.Fprintf(tw, "\t")
+	for _, name := range names {
+		fmt.Fprintf(tw, "%s\t", name)
+	}
+	fmt.Fprintln(tw)
+
+	row("count", func(s Stats) string { return fmt.Sprint(s.Count) })
+	row("mean", number(func(s Stats) float64 { return s.Mean }))
+	row("std", number(func(s Stats) float64 { return s.Std }))
+	row("min", number(func(s Stats) float64 { return s.Min }))
+	row("25%", number(func(s Stats) float64 { return s.Q1 }))
+	row("50%", number(func(s Stats) float64 { return s.Median }))
+	row("75%", number(func(s Stats) float64 { return s.Q3 }))
+	for i, p := range Percentiles {
+		row(fmt.Sprintf("%g%%", p), number(func(s Stats) float64 {
+			if i < len(s.Percentiles) {
+				return s.Percentiles[i]
+			}
+			return math.NaN()
+		}))
+	}
+	row("max", number(func(s Stats) float64 { return s.Max }))
+	return tw.Flush()
+}
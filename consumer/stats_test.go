@@ -0,0 +1,115 @@
+/*
+Copyright © 2020 Pavel Tisnovsky
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package consumer
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+// closeTo compares values computed by pandas, which are printed with six
+// decimal places.
+func closeTo(a, b float64) bool {
+	if math.IsNaN(a) || math.IsNaN(b) {
+		return math.IsNaN(a) && math.IsNaN(b)
+	}
+	return math.Abs(a-b) < 1e-6
+}
+
+func TestPercentile(t *testing.T) {
+	tests := []struct {
+		sorted []float64
+		p      float64
+		want   float64
+	}{
+		// pd.Series([1, 2, 3, 4]).quantile(...)
+		{[]float64{1, 2, 3, 4}, 0, 1},
+		{[]float64{1, 2, 3, 4}, 25, 1.75},
+		{[]float64{1, 2, 3, 4}, 50, 2.5},
+		{[]float64{1, 2, 3, 4}, 75, 3.25},
+		{[]float64{1, 2, 3, 4}, 95, 3.85},
+		{[]float64{1, 2, 3, 4}, 100, 4},
+		{[]float64{1, 2, 3, 4, 5}, 50, 3},
+		{[]float64{7}, 90, 7},
+		{nil, 50, math.NaN()},
+	}
+	for _, tt := range tests {
+		if got := Percentile(tt.sorted, tt.p); !closeTo(got, tt.want) {
+			t.Errorf("Percentile(%v, %v) = %v, want %v", tt.sorted, tt.p, got, tt.want)
+		}
+	}
+}
+
+func TestDescribe(t *testing.T) {
+	tests := []struct {
+		name   string
+		values []float64
+		want   Stats
+	}{
+		// pd.Series([4, 1, 3, 2]).describe(percentiles=[.25, .5, .75, .9, .95, .99])
+		{"pandas", []float64{4, 1, 3, 2}, Stats{
+			Count: 4, Mean: 2.5, Std: 1.290994, Min: 1, Q1: 1.75, Median: 2.5, Q3: 3.25, Max: 4,
+			Percentiles: []float64{3.7, 3.85, 3.97},
+		}},
+		// standard deviation of one value is not defined (ddof=1)
+		{"single value", []float64{5}, Stats{
+			Count: 1, Mean: 5, Std: math.NaN(), Min: 5, Q1: 5, Median: 5, Q3: 5, Max: 5,
+			Percentiles: []float64{5, 5, 5},
+		}},
+		{"empty", nil, Stats{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Describe(tt.values)
+			values := []struct {
+				name      string
+				got, want float64
+			}{
+				{"count", float64(got.Count), float64(tt.want.Count)},
+				{"mean", got.Mean, tt.want.Mean},
+				{"std", got.Std, tt.want.Std},
+				{"min", got.Min, tt.want.Min},
+				{"25%", got.Q1, tt.want.Q1},
+				{"50%", got.Median, tt.want.Median},
+				{"75%", got.Q3, tt.want.Q3},
+				{"max", got.Max, tt.want.Max},
+			}
+			for _, v := range values {
+				if !closeTo(v.got, v.want) {
+					t.Errorf("%s = %v, want %v", v.name, v.got, v.want)
+				}
+			}
+			if len(got.Percentiles) != len(tt.want.Percentiles) {
+				t.Fatalf("percentiles = %v, want %v", got.Percentiles, tt.want.Percentiles)
+			}
+			for i, p := range got.Percentiles {
+				if !closeTo(p, tt.want.Percentiles[i]) {
+					t.Errorf("p%g = %v, want %v", Percentiles[i], p, tt.want.Percentiles[i])
+				}
+			}
+		})
+	}
+}
+
+func TestDescribeKeepsInput(t *testing.T) {
+	values := []float64{4, 1, 3, 2}
+	Describe(values)
+	if want := []float64{4, 1, 3, 2}; !reflect.DeepEqual(values, want) {
+		t.Errorf("values = %v, want %v", values, want)
+	}
+}
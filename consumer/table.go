@@ -0,0 +1,130 @@
+/*
+Copyright © 2020 Pavel Tisnovsky
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package consumer contains functions used to analyze logs of the Kafka
+// consumer benchmark described in consumer_benchmarks.py. The benchmark
+// produces two CSV files:
+//
+//	consumer_durations.csv        Duration (ms) and Offset of each message
+//	consumer_steps_durations.csv  durations (ns) of five processing steps
+package consumer
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// Names of CSV files used by the benchmark.
+const (
+	DurationsFile      = "consumer_durations.csv"
+	StepsDurationsFile = "consumer_steps_durations.csv"
+)
+
+// Names of columns stored in CSV files.
+const (
+	DurationColumn = "Duration"
+	OffsetColumn   = "Offset"
+)
+
+// Steps contains names of all steps performed during consume message
+// operation, in the order they are performed.
+var Steps = []string{"Read", "Whitelisting", "Marshalling", "Time check", "DB store"}
+
+// Table is a set of named numeric columns read from CSV file.
+type Table struct {
+	Names   []string
+	Columns [][]float64
+}
+
+// Column returns values of column with given name.
+func (t *Table) Column(name string) ([]float64, error) {
+	for i, n := range t.Names {
+		if n == name {
+			return t.Columns[i], nil
+		}
+	}
+	return nil, fmt.Errorf("column %q not found, available columns: %s",
+		name, strings.Join(t.Names, ", "))
+}
+
+// Rows returns number of rows in table.
+func (t *Table) Rows() int {
+	if len(t.Columns) == 0 {
+		return 0
+	}
+	return len(t.Columns[0])
+}
+
+// ReadTable reads CSV with header from given reader. All values must be
+// numbers, pandas-style index column without name is skipped.
+func ReadTable(r io.Reader) (*Table, error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("header: %w", err)
+	}
+
+	// pandas writes index as the first column with empty name
+	skip := len(header) > 0 && header[0] == ""
+	if skip {
+		header = header[1:]
+	}
+	t := &Table{Names: header, Columns: make([][]float64, len(header))}
+
+	line := 1
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		line++
+		if err != nil {
+			return nil, err
+		}
+		if skip {
+			record = record[1:]
+		}
+		for i, field := range record {
+			value, err := strconv.ParseFloat(field, 64)
+			if err != nil {
+				return nil, fmt.Errorf("line %d, column %q: %w", line, t.Names[i], err)
+			}
+			t.Columns[i] = append(t.Columns[i], value)
+		}
+	}
+	return t, nil
+}
+
+// ReadTableFile reads CSV file with header.
+func ReadTableFile(filename string) (*Table, error) {
+	f, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	t, err := ReadTable(f)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", filename, err)
+	}
+	return t, nil
+}
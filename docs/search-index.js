@@ -0,0 +1,2295 @@
+var searchIndex = {"entries": [{"p":"gonum_std.html","a":"section-0","t":"Knihovna Gonum › Knihovna Gonum","s":"//go:build example"},{"p":"gonum_std.html","a":"section-1","t":"Knihovna Gonum › Úvodní informace o knihovně Gonum","s":"Knihovna Gonum Úvodní informace o knihovně Gonum Samotný programovací jazyk Go obsahuje podporu pro práci s maticemi a řezy (ostatně se jedná o základní datové …"},{"p":"gonum_std.html","a":"section-2","t":"Knihovna Gonum › Úvodní informace o knihovně Gonum","s":"Poznámka: na tomto místě je však vhodné poznamenat, že integrace NumPy do Pythonu je mnohem lepší, než je tomu v případě projektu Gonum a programovacího jazyka …"},{"p":"gonum_std.html","a":"section-3","t":"Knihovna Gonum › Úvodní informace o knihovně Gonum","s":"Používat budeme dva balíčky - standardní balíček fmt a balíček mat z knihovny Gonum :"},{"p":"gonum_std.html","a":"section-4","t":"Knihovna Gonum › Úvodní informace o knihovně Gonum","s":"V tomto studijním materiálu využijeme jednu velmi užitečnou vlastnost programovacího jazyka Go - automatické odvození typu proměnné na základě její hodnoty. Zaj…"},{"p":"gonum_std.html","a":"section-5","t":"Knihovna Gonum › Matice","s":"Matice Pro reprezentaci matic se používá několik struktur. Základem je je dense matrix používaná pro matice běžné velikosti, které obsahují libovolné prvky (a k…"},{"p":"gonum_std.html","a":"section-6","t":"Knihovna Gonum › Matice","s":"Matici lze přímo vytisknout, ovšem výsledek nebývá příliš čitelný, protože se vytiskne interní reprezentace matice v operační paměti"},{"p":"gonum_std.html","a":"section-7","t":"Knihovna Gonum › Matice","s":"Podporováno je i naplnění matice daty - postačuje namísto třetího parametru, v němž jsme v předchozí deklaraci použili nil , předat řez s hodnotami prvků matice"},{"p":"gonum_std.html","a":"section-8","t":"Knihovna Gonum › Zobrazení vybraného obsahu rozsáhlých matic","s":"Poznámka: zde můžeme vidět, že práce s maticemi není tak elegantní, jako je tomu například v knihovně NumPy . Zobrazení vybraného obsahu rozsáhlých matic Nyní s…"},{"p":"gonum_std.html","a":"section-9","t":"Knihovna Gonum › Zobrazení vybraného obsahu rozsáhlých matic","s":"Tuto matici můžeme naplnit daty, a to pomocí metody Set popsané níže (vyplníme jen prvky na hlavní úhlopříčce):"},{"p":"gonum_std.html","a":"section-10","t":"Knihovna Gonum › Zobrazení vybraného obsahu rozsáhlých matic","s":"Přímý tisk hodnoty takové matice ovšem není v žádném případě přehledný:"},{"p":"gonum_std.html","a":"section-11","t":"Knihovna Gonum › Zobrazení vybraného obsahu rozsáhlých matic","s":"Výhodnější je použití funkce mat.Formatted , které se ve druhém parametru předá oddělovač hodnot na řádku a ve třetím parametru pak informace o tom, kolik mezní…"},{"p":"gonum_std.html","a":"section-12","t":"Knihovna Gonum › Zobrazení vybraného obsahu rozsáhlých matic","s":"S mnohem čitelnějšími výsledky:"},{"p":"gonum_std.html","a":"section-13","t":"Knihovna Gonum › Zobrazení vybraného obsahu rozsáhlých matic","s":"Podobný příkaz, ovšem pro mezních pět řádků a sloupců:"},{"p":"gonum_std.html","a":"section-14","t":"Knihovna Gonum › Zobrazení vybraného obsahu rozsáhlých matic","s":"S výsledky:"},{"p":"gonum_std.html","a":"section-15","t":"Knihovna Gonum › Transpozice a součet matic","s":"Transpozice a součet matic Mezi další podporované základní maticové operace patří transpozice a součet matic. Nejdříve nadeklarujeme novou proměnnou určenou pro…"},{"p":"gonum_std.html","a":"section-16","t":"Knihovna Gonum › Transpozice a součet matic","s":"Dále vytvoříme dvě matice se třemi řádky a čtyřmi prvky na řádku"},{"p":"gonum_std.html","a":"section-17","t":"Knihovna Gonum › Transpozice a součet matic","s":"Obě matice vytiskneme v čitelném formátu"},{"p":"gonum_std.html","a":"section-18","t":"Knihovna Gonum › Transpozice a součet matic","s":"Obsah matic m1 a m2 zobrazený na standardním výstupu by měl být následující:"},{"p":"gonum_std.html","a":"section-19","t":"Knihovna Gonum › Transponovaná matice","s":"Transponovaná matice Výpočet transponované matice s jejím následným vytištěním se provede zavoláním metody nazvané jednoduše T"},{"p":"gonum_std.html","a":"section-20","t":"Knihovna Gonum › Transponovaná matice","s":"Výsledek - transponovaná matice:"},{"p":"gonum_std.html","a":"section-21","t":"Knihovna Gonum › Součet matic","s":"Součet matic Součet matic o stejné velikosti je řešen metodou Add . Tato metoda sečte dvě matice předané v parametrech a upraví příjemce (reciver)"},{"p":"gonum_std.html","a":"section-22","t":"Knihovna Gonum › Součet matic","s":"Výsledek:"},{"p":"gonum_std.html","a":"section-23","t":"Knihovna Gonum › Maticový součin a podobné operace","s":"Poznámka: v této knihovně vždy platí - funkce ani metody nemění obsah svých parametrů (matic). Změnit lze obsah jediné hodnoty - příjemce ( receiveru ) u metod.…"},{"p":"gonum_std.html","a":"section-24","t":"Knihovna Gonum › Maticový součin a podobné operace","s":"Výsledek:"},{"p":"gonum_std.html","a":"section-25","t":"Knihovna Gonum › Násobení prvek po prvku","s":"Násobení prvek po prvku Provést lze i násobení dvou matic prvek po prvku (což ovšem neodpovídá maticovému násobení):"},{"p":"gonum_std.html","a":"section-26","t":"Knihovna Gonum › Násobení prvek po prvku","s":"Výsledek:"},{"p":"gonum_std.html","a":"section-27","t":"Knihovna Gonum › Jednorozměrné vektory","s":"Jednorozměrné vektory V předchozím textu jsme se zabývali převážně popisem práce s běžnými čtvercovými a obdélníkovými maticemi, i když možnosti tohoto balíčku …"},{"p":"gonum_std.html","a":"section-28","t":"Knihovna Gonum › Jednorozměrné vektory","s":"Vektor lze pochopitelně vytisknout"},{"p":"gonum_std.html","a":"section-29","t":"Knihovna Gonum › Jednorozměrné vektory","s":"Jak jsme si již řekli v předchozím odstavci, jedná se o sloupcový vektor:"},{"p":"gonum_std.html","a":"section-30","t":"Knihovna Gonum › Jednorozměrné vektory","s":"V případě, že budeme chtít vektor inicializovat prvky se známou hodnotou, použijeme sice stejný konstruktor, ale namísto druhé hodnoty nil lze předat řez s hodn…"},{"p":"gonum_std.html","a":"section-31","t":"Knihovna Gonum › Jednorozměrné vektory","s":"U vektorů lze zjistit jejich velikost (délka zde vlastně odpovídá výšce) a taktéž kapacitu"},{"p":"gonum_std.html","a":"section-32","t":"Knihovna Gonum › Jednorozměrné vektory","s":"Metoda Dims vrací dimenzi vektoru - n řádků a jeden sloupec:"},{"p":"gonum_std.html","a":"section-33","t":"Knihovna Gonum › Jednorozměrné vektory","s":"Pochopitelně je možné vytvořit i řádkový vektor o to maticovou operací transpozice zapisovanou metodou se jménem T"},{"p":"gonum_std.html","a":"section-34","t":"Knihovna Gonum › Jednorozměrné vektory","s":"S tímto výsledkem"},{"p":"gonum_std.html","a":"section-35","t":"Knihovna Gonum › Získání řezu (slice) z vektoru","s":"Poznámka: výsledkem je v tomto případě matice s jedním řádkem Získání řezu (slice) z vektoru Často je zapotřebí z vektoru získat pouze určitou část. V případě p…"},{"p":"gonum_std.html","a":"section-36","t":"Knihovna Gonum › Získání řezu (slice) z vektoru","s":"Následně vytvoříme řez tvořený prvky s indexy 4 a 5 (tedy kromě prvku číslo 6)"},{"p":"gonum_std.html","a":"section-37","t":"Knihovna Gonum › Získání řezu (slice) z vektoru","s":"Který běžným způsobem vytiskneme"},{"p":"gonum_std.html","a":"section-38","t":"Knihovna Gonum › Získání řezu (slice) z vektoru","s":"Výsledkem by měl být vektor se dvěma prvky vypadající následovně"},{"p":"gonum_std.html","a":"section-39","t":"Knihovna Gonum › Získání řezu (slice) z vektoru","s":"Poznámka: povšimněte si, že první prvek řezu je určen \"včetně\", zatímco druhý prvek \"kromě\" (uzavřený vs. otevřený interval). Podobně lze vytvořit řez obsahujíc…"},{"p":"gonum_std.html","a":"section-40","t":"Knihovna Gonum › Získání řezu (slice) z vektoru","s":"Výsledkem by měl být vektor se stejnými prvky jako vektor původní"},{"p":"gonum_std.html","a":"section-41","t":"Knihovna Gonum › Získání řezu (slice) z vektoru","s":"Indexy prvků musí být kladná čísla - jinými slovy to znamená, že není povoleno počítat indexy od konce vektoru tak, jak to známe z některých jiných knihoven. Po…"},{"p":"gonum_std.html","a":"section-42","t":"Knihovna Gonum › Získání řezu (slice) z vektoru","s":"mat.Formatted(v.SliceVec(0, -1)) Řez vektoru je skutečným řezem ve smyslu, že se jedná o \"pohled\" na původní vektor. V dalším příkladu vytvoříme řez nazvaný w ,…"},{"p":"gonum_std.html","a":"section-43","t":"Knihovna Gonum › Získání řezu (slice) z vektoru","s":"Výsledek získaný již známou funkcí Formatted by měl vypadat následovně"},{"p":"gonum_std.html","a":"section-44","t":"Knihovna Gonum › Čtení a modifikace prvků vektoru","s":"Čtení a modifikace prvků vektoru Způsob nastavení nové hodnoty prvku vektoru jsme již viděli v předchozí podkapitole. Pro tento účel se používá metoda nazvaná S…"},{"p":"gonum_std.html","a":"section-45","t":"Knihovna Gonum › Čtení a modifikace prvků vektoru","s":"Změněný vektor bude mít opět deset prvků"},{"p":"gonum_std.html","a":"section-46","t":"Knihovna Gonum › Čtení a modifikace prvků vektoru","s":"Existují dvě metody určené pro přečtení hodnoty prvku z vektoru. První metoda se jmenuje At a používá se i pro čtení prvků z dvourozměrných matic (u sloupcových…"},{"p":"gonum_std.html","a":"section-47","t":"Knihovna Gonum › Čtení a modifikace prvků vektoru","s":"Druhá metoda se jmenuje AtVec a předává se jí jen jediný index. Použitelná je tedy jen v případě jednorozměrných vektorů."},{"p":"gonum_std.html","a":"section-48","t":"Knihovna Gonum › Další podporované operace nad vektory","s":"Další podporované operace nad vektory V této podkapitole si popíšeme některé další operace, které lze provádět s vektory. Nejdříve vytvoříme dvojici vektorů, kt…"},{"p":"gonum_std.html","a":"section-49","t":"Knihovna Gonum › Další podporované operace nad vektory","s":"Třetí vektor bude použit jako cíl pro některé vybrané operace"},{"p":"gonum_std.html","a":"section-50","t":"Knihovna Gonum › Součet vektorů","s":"Součet vektorů Operace součtu dvou vektorů realizovaná metodou AddVec . V tomto případě se modifikuje její příjemce ( receiver )"},{"p":"gonum_std.html","a":"section-51","t":"Knihovna Gonum › Součet vektorů","s":"Součet vektoru v2 se sebou samým s uložením výsledku do vektoru v"},{"p":"gonum_std.html","a":"section-52","t":"Knihovna Gonum › Rozdíl vektorů","s":"Rozdíl vektorů Operace rozdílu vektorů, opět s modifikací příjemce"},{"p":"gonum_std.html","a":"section-53","t":"Knihovna Gonum › Změna měřítka (natažení...)","s":"Změna měřítka (natažení...) Změna měřítka, tj. vynásobení všech prvků vektoru nějakou konstantou, se realizuje metodou nazvanou ScaleVec"},{"p":"gonum_std.html","a":"section-54","t":"Knihovna Gonum › Vynásobení korespondujících prvků vektorů","s":"Vynásobení korespondujících prvků vektorů Vynásobení dvou vektorů stylem prvek po prvku (nejedná se o vektorový součin)"},{"p":"gonum_std.html","a":"section-55","t":"Knihovna Gonum › Součin matice a vektoru","s":"Součin matice a vektoru Podporována je i operace vynásobení matice a vektoru, samozřejmě za předpokladu, že počet sloupců matice bude odpovídat počtu řádků slou…"},{"p":"gonum_std.html","a":"section-56","t":"Knihovna Gonum › Součin matice a vektoru","s":"Vynásobení vektoru maticí reprezentující otočení okolo z-ové osy o 90 stupňů by mohlo být realizováno následujícím kódem"},{"p":"gonum_std.html","a":"section-57","t":"Knihovna Gonum › Skalární součin","s":"Skalární součin Skalární součin dvou vektorů o stejné velikosti se provádí funkcí Dot . Výsledkem je hodnota typu float64 , tedy skutečně skalár."},{"p":"gonum_std.html","a":"section-58","t":"Knihovna Gonum › Skalární součin","s":"Získání prvku s největší a nejmenší hodnotou:"},{"p":"gonum_std.html","a":"section-59","t":"Knihovna Gonum › Skalární součin","s":"Součet všech prvků vektoru:"},{"p":"gonum_std.html","a":"section-60","t":"Knihovna Gonum › Práce s obecnými dvourozměrnými maticemi","s":"Práce s obecnými dvourozměrnými maticemi Obecnou dvourozměrnou matici vytváříme konstruktorem NewDense , které se předá počet řádků následovaný počtem sloupců"},{"p":"gonum_std.html","a":"section-61","t":"Knihovna Gonum › Práce s obecnými dvourozměrnými maticemi","s":"Konstrukce matice s inicializací jejich prvků se provede předáním řezu s hodnotami prvků"},{"p":"gonum_std.html","a":"section-62","t":"Knihovna Gonum › Práce s obecnými dvourozměrnými maticemi","s":"Třetí matice, tentokrát se třemi řádky a čtyřmi sloupci"},{"p":"gonum_std.html","a":"section-63","t":"Knihovna Gonum › Práce s obecnými dvourozměrnými maticemi","s":"Čtvercová matice 3x3 prvky"},{"p":"gonum_std.html","a":"section-64","t":"Knihovna Gonum › Přečtení sloupce z matice","s":"Přečtení sloupce z matice Přečtení i-tého sloupce matice zajišťuje metoda Col . Výsledkem je v tomto případě běžný řez programovacího jazyka Go"},{"p":"gonum_std.html","a":"section-65","t":"Knihovna Gonum › Přečtení řádku z matice","s":"Přečtení řádku z matice Přečtení j-tého řádku matice je provedeno metodou Row . Výsledkem je v tomto případě opět běžný řez programovacího jazyka Go (toto chová…"},{"p":"gonum_std.html","a":"section-66","t":"Knihovna Gonum › Výpočet determinantu","s":"Výpočet determinantu O výpočet determinantu matice 3x3 prvky se stará metoda nazvaná Det . V tomto případě je výsledkem skalární hodnota typu float64"},{"p":"gonum_std.html","a":"section-67","t":"Knihovna Gonum › Prvek s minimální a maximální hodnotou, součet hodnot prvků","s":"Prvek s minimální a maximální hodnotou, součet hodnot prvků Opět můžeme použít funkce pro získání prvku s nejmenší hodnotou, největší hodnotou a pro součet (sum…"},{"p":"gonum_std.html","a":"section-68","t":"Knihovna Gonum › Získání diagonální matice","s":"Získání diagonální matice Poslední zajímavou metodou určenou pro zpracování matic je metoda, která vrací diagonální matici (všechny prvky kromě prvků na hlavní …"},{"p":"gonum_std.html","a":"section-69","t":"Knihovna Gonum › Symetrické matice","s":"Symetrické matice V knihovně mat existuje i konstruktor pro symetrické matice. Chování tohoto konstruktoru je ovšem poněkud zvláštní - předat je mu totiž nutné …"},{"p":"gonum_std.html","a":"section-70","t":"Knihovna Gonum › Symetrické matice","s":"Symetrické matice zachovávají většinu základních vlastností běžných matic, tj. můžeme například získat informace o jejich kapacitě, velikosti (v jednotlivých di…"},{"p":"gonum_std.html","a":"section-71","t":"Knihovna Gonum › Symetrické matice","s":"Vytvořit je možné i transformovanou matici, což je ovšem jen kopie matice původní:"},{"p":"gonum_std.html","a":"section-72","t":"Knihovna Gonum › Symetrické matice","s":"Prvky symetrické matice se nastavují metodou SetSym (jiná metoda ostatně ani není k dispozici). Tato metoda pochopitelně zachovává \"symetričnost\" matice, tj. zm…"},{"p":"gonum_std.html","a":"section-73","t":"Knihovna Gonum › Diagonální matice","s":"Diagonální matice Další variantou matic jsou diagonální matice. Ty lze vytvořit konstruktorem NewDiagDense"},{"p":"gonum_std.html","a":"section-74","t":"Knihovna Gonum › Diagonální matice","s":"Konstruktoru je možné předat hodnoty všech prvků na hlavní diagonále:"},{"p":"gonum_std.html","a":"section-75","t":"Knihovna Gonum › Diagonální matice","s":"A opět jsou k dispozici metody pro získání základních informací o existující matici"},{"p":"gonum_std.html","a":"section-76","t":"Knihovna Gonum › Diagonální matice","s":"Pro nastavení hodnoty prvku diagonální matice se používá metoda nazvaná SetDiag"},{"p":"gonum_std.html","a":"section-77","t":"Knihovna Gonum › Trojúhelníkové matice","s":"Trojúhelníkové matice V knihovně mat jsou vývojářům k dispozici i funkce a metody určené pro práci s trojúhelníkovými maticemi. Opět si nejprve řekněme, jakým z…"},{"p":"gonum_std.html","a":"section-78","t":"Knihovna Gonum › Trojúhelníkové matice","s":"Dolní trojúhelníková matice inicializovaná shodnými hodnotami se konstruuje následovně"},{"p":"gonum_std.html","a":"section-79","t":"Knihovna Gonum › Trojúhelníkové matice","s":"Získat můžeme pohled obsahující pouze prvky na hlavní diagonále:"},{"p":"gonum_std.html","a":"section-80","t":"Knihovna Gonum › Trojúhelníkové matice","s":"Trojúhelníkové matice lze transponovat, čímž se z horní matice stane dolní a naopak"},{"p":"gonum_std.html","a":"section-81","t":"Knihovna Gonum › Trojúhelníkové matice","s":"Pro nastavení hodnot prvků trojúhelníkové matice slouží metoda NewTriDense , která zajistí, aby se neměnily prvky v té části trojúhelníkové matice, které musí b…"},{"p":"gonum_std.html","a":"section-82","t":"Knihovna Gonum › Trojúhelníkové matice","s":"toto provést nelze nelze: t3.SetTri(2, 0, 100) vedlo by k chybě při běhu: mat: triangular set out of bounds Prvek ve třetím sloupci a na prvním řádku naopak změ…"},{"p":"gonum_std.html","a":"section-83","t":"Knihovna Gonum › finito █","s":"Další informace o datových typech, metodách a funkcích poskytovaných balíčkem mat naleznete na stránce https://godoc.org/gonum.org/v1/gonum/mat (https://godoc.o…"},{"p":"gonum_std.html","a":"section-84","t":"Knihovna Gonum › finito █","s":"Odkazy pro další studium: 1. The Gonum Numerical Computing Package (https://www.gonum.org/post/introtogonum/) 1. Gorilla REPL: interaktivní prostředí pro progra…"},{"p":"gonum_output_as_comments.html","a":"section-0","t":"Knihovna Gonum › Knihovna Gonum","s":"//go:build example"},{"p":"gonum_output_as_comments.html","a":"section-1","t":"Knihovna Gonum › Úvodní informace o knihovně Gonum","s":"Knihovna Gonum Úvodní informace o knihovně Gonum Samotný programovací jazyk Go obsahuje podporu pro práci s maticemi a řezy (ostatně se jedná o základní datové …"},{"p":"gonum_output_as_comments.html","a":"section-2","t":"Knihovna Gonum › Úvodní informace o knihovně Gonum","s":"Poznámka: na tomto místě je však vhodné poznamenat, že integrace NumPy do Pythonu je mnohem lepší, než je tomu v případě projektu Gonum a programovacího jazyka …"},{"p":"gonum_output_as_comments.html","a":"section-3","t":"Knihovna Gonum › Úvodní informace o knihovně Gonum","s":"Používat budeme dva balíčky - standardní balíček fmt a balíček mat z knihovny Gonum :"},{"p":"gonum_output_as_comments.html","a":"section-4","t":"Knihovna Gonum › Úvodní informace o knihovně Gonum","s":"V tomto studijním materiálu využijeme jednu velmi užitečnou vlastnost programovacího jazyka Go - automatické odvození typu proměnné na základě její hodnoty. Zaj…"},{"p":"gonum_output_as_comments.html","a":"section-5","t":"Knihovna Gonum › Matice","s":"Matice Pro reprezentaci matic se používá několik struktur. Základem je je dense matrix používaná pro matice běžné velikosti, které obsahují libovolné prvky (a k…"},{"p":"gonum_output_as_comments.html","a":"section-6","t":"Knihovna Gonum › Matice","s":"Matici lze přímo vytisknout, ovšem výsledek nebývá příliš čitelný, protože se vytiskne interní reprezentace matice v operační paměti"},{"p":"gonum_output_as_comments.html","a":"section-7","t":"Knihovna Gonum › Matice","s":"Podporováno je i naplnění matice daty - postačuje namísto třetího parametru, v němž jsme v předchozí deklaraci použili nil , předat řez s hodnotami prvků matice"},{"p":"gonum_output_as_comments.html","a":"section-8","t":"Knihovna Gonum › Zobrazení vybraného obsahu rozsáhlých matic","s":"Poznámka: zde můžeme vidět, že práce s maticemi není tak elegantní, jako je tomu například v knihovně NumPy . Zobrazení vybraného obsahu rozsáhlých matic Nyní s…"},{"p":"gonum_output_as_comments.html","a":"section-9","t":"Knihovna Gonum › Zobrazení vybraného obsahu rozsáhlých matic","s":"Tuto matici můžeme naplnit daty, a to pomocí metody Set popsané níže (vyplníme jen prvky na hlavní úhlopříčce):"},{"p":"gonum_output_as_comments.html","a":"section-10","t":"Knihovna Gonum › Zobrazení vybraného obsahu rozsáhlých matic","s":"Přímý tisk hodnoty takové matice ovšem není v žádném případě přehledný:"},{"p":"gonum_output_as_comments.html","a":"section-11","t":"Knihovna Gonum › Zobrazení vybraného obsahu rozsáhlých matic","s":"Výhodnější je použití funkce mat.Formatted , které se ve druhém parametru předá oddělovač hodnot na řádku a ve třetím parametru pak informace o tom, kolik mezní…"},{"p":"gonum_output_as_comments.html","a":"section-12","t":"Knihovna Gonum › Zobrazení vybraného obsahu rozsáhlých matic","s":"S mnohem čitelnějšími výsledky:"},{"p":"gonum_output_as_comments.html","a":"section-13","t":"Knihovna Gonum › Zobrazení vybraného obsahu rozsáhlých matic","s":"Podobný příkaz, ovšem pro mezních pět řádků a sloupců:"},{"p":"gonum_output_as_comments.html","a":"section-14","t":"Knihovna Gonum › Zobrazení vybraného obsahu rozsáhlých matic","s":"S výsledky:"},{"p":"gonum_output_as_comments.html","a":"section-15","t":"Knihovna Gonum › Transpozice a součet matic","s":"Transpozice a součet matic Mezi další podporované základní maticové operace patří transpozice a součet matic. Nejdříve nadeklarujeme novou proměnnou určenou pro…"},{"p":"gonum_output_as_comments.html","a":"section-16","t":"Knihovna Gonum › Transpozice a součet matic","s":"Dále vytvoříme dvě matice se třemi řádky a čtyřmi prvky na řádku"},{"p":"gonum_output_as_comments.html","a":"section-17","t":"Knihovna Gonum › Transpozice a součet matic","s":"Obě matice vytiskneme v čitelném formátu"},{"p":"gonum_output_as_comments.html","a":"section-18","t":"Knihovna Gonum › Transpozice a součet matic","s":"Obsah matic m1 a m2 zobrazený na standardním výstupu by měl být následující:"},{"p":"gonum_output_as_comments.html","a":"section-19","t":"Knihovna Gonum › Transponovaná matice","s":"Transponovaná matice Výpočet transponované matice s jejím následným vytištěním se provede zavoláním metody nazvané jednoduše T"},{"p":"gonum_output_as_comments.html","a":"section-20","t":"Knihovna Gonum › Transponovaná matice","s":"Výsledek - transponovaná matice:"},{"p":"gonum_output_as_comments.html","a":"section-21","t":"Knihovna Gonum › Součet matic","s":"Součet matic Součet matic o stejné velikosti je řešen metodou Add . Tato metoda sečte dvě matice předané v parametrech a upraví příjemce (reciver)"},{"p":"gonum_output_as_comments.html","a":"section-22","t":"Knihovna Gonum › Součet matic","s":"Výsledek:"},{"p":"gonum_output_as_comments.html","a":"section-23","t":"Knihovna Gonum › Maticový součin a podobné operace","s":"Poznámka: v této knihovně vždy platí - funkce ani metody nemění obsah svých parametrů (matic). Změnit lze obsah jediné hodnoty - příjemce ( receiveru ) u metod.…"},{"p":"gonum_output_as_comments.html","a":"section-24","t":"Knihovna Gonum › Maticový součin a podobné operace","s":"Výsledek:"},{"p":"gonum_output_as_comments.html","a":"section-25","t":"Knihovna Gonum › Násobení prvek po prvku","s":"Násobení prvek po prvku Provést lze i násobení dvou matic prvek po prvku (což ovšem neodpovídá maticovému násobení):"},{"p":"gonum_output_as_comments.html","a":"section-26","t":"Knihovna Gonum › Násobení prvek po prvku","s":"Výsledek:"},{"p":"gonum_output_as_comments.html","a":"section-27","t":"Knihovna Gonum › Jednorozměrné vektory","s":"Jednorozměrné vektory V předchozím textu jsme se zabývali převážně popisem práce s běžnými čtvercovými a obdélníkovými maticemi, i když možnosti tohoto balíčku …"},{"p":"gonum_output_as_comments.html","a":"section-28","t":"Knihovna Gonum › Jednorozměrné vektory","s":"Vektor lze pochopitelně vytisknout"},{"p":"gonum_output_as_comments.html","a":"section-29","t":"Knihovna Gonum › Jednorozměrné vektory","s":"Jak jsme si již řekli v předchozím odstavci, jedná se o sloupcový vektor:"},{"p":"gonum_output_as_comments.html","a":"section-30","t":"Knihovna Gonum › Jednorozměrné vektory","s":"V případě, že budeme chtít vektor inicializovat prvky se známou hodnotou, použijeme sice stejný konstruktor, ale namísto druhé hodnoty nil lze předat řez s hodn…"},{"p":"gonum_output_as_comments.html","a":"section-31","t":"Knihovna Gonum › Jednorozměrné vektory","s":"U vektorů lze zjistit jejich velikost (délka zde vlastně odpovídá výšce) a taktéž kapacitu"},{"p":"gonum_output_as_comments.html","a":"section-32","t":"Knihovna Gonum › Jednorozměrné vektory","s":"Metoda Dims vrací dimenzi vektoru - n řádků a jeden sloupec:"},{"p":"gonum_output_as_comments.html","a":"section-33","t":"Knihovna Gonum › Jednorozměrné vektory","s":"Pochopitelně je možné vytvořit i řádkový vektor o to maticovou operací transpozice zapisovanou metodou se jménem T"},{"p":"gonum_output_as_comments.html","a":"section-34","t":"Knihovna Gonum › Jednorozměrné vektory","s":"S tímto výsledkem"},{"p":"gonum_output_as_comments.html","a":"section-35","t":"Knihovna Gonum › Získání řezu (slice) z vektoru","s":"Poznámka: výsledkem je v tomto případě matice s jedním řádkem Získání řezu (slice) z vektoru Často je zapotřebí z vektoru získat pouze určitou část. V případě p…"},{"p":"gonum_output_as_comments.html","a":"section-36","t":"Knihovna Gonum › Získání řezu (slice) z vektoru","s":"Následně vytvoříme řez tvořený prvky s indexy 4 a 5 (tedy kromě prvku číslo 6)"},{"p":"gonum_output_as_comments.html","a":"section-37","t":"Knihovna Gonum › Získání řezu (slice) z vektoru","s":"Který běžným způsobem vytiskneme"},{"p":"gonum_output_as_comments.html","a":"section-38","t":"Knihovna Gonum › Získání řezu (slice) z vektoru","s":"Výsledkem by měl být vektor se dvěma prvky vypadající následovně"},{"p":"gonum_output_as_comments.html","a":"section-39","t":"Knihovna Gonum › Získání řezu (slice) z vektoru","s":"Poznámka: povšimněte si, že první prvek řezu je určen \"včetně\", zatímco druhý prvek \"kromě\" (uzavřený vs. otevřený interval). Podobně lze vytvořit řez obsahujíc…"},{"p":"gonum_output_as_comments.html","a":"section-40","t":"Knihovna Gonum › Získání řezu (slice) z vektoru","s":"Výsledkem by měl být vektor se stejnými prvky jako vektor původní"},{"p":"gonum_output_as_comments.html","a":"section-41","t":"Knihovna Gonum › Získání řezu (slice) z vektoru","s":"Indexy prvků musí být kladná čísla - jinými slovy to znamená, že není povoleno počítat indexy od konce vektoru tak, jak to známe z některých jiných knihoven. Po…"},{"p":"gonum_output_as_comments.html","a":"section-42","t":"Knihovna Gonum › Získání řezu (slice) z vektoru","s":"mat.Formatted(v.SliceVec(0, -1)) Řez vektoru je skutečným řezem ve smyslu, že se jedná o \"pohled\" na původní vektor. V dalším příkladu vytvoříme řez nazvaný w ,…"},{"p":"gonum_output_as_comments.html","a":"section-43","t":"Knihovna Gonum › Získání řezu (slice) z vektoru","s":"Výsledek získaný již známou funkcí Formatted by měl vypadat následovně"},{"p":"gonum_output_as_comments.html","a":"section-44","t":"Knihovna Gonum › Čtení a modifikace prvků vektoru","s":"Čtení a modifikace prvků vektoru Způsob nastavení nové hodnoty prvku vektoru jsme již viděli v předchozí podkapitole. Pro tento účel se používá metoda nazvaná S…"},{"p":"gonum_output_as_comments.html","a":"section-45","t":"Knihovna Gonum › Čtení a modifikace prvků vektoru","s":"Změněný vektor bude mít opět deset prvků"},{"p":"gonum_output_as_comments.html","a":"section-46","t":"Knihovna Gonum › Čtení a modifikace prvků vektoru","s":"Existují dvě metody určené pro přečtení hodnoty prvku z vektoru. První metoda se jmenuje At a používá se i pro čtení prvků z dvourozměrných matic (u sloupcových…"},{"p":"gonum_output_as_comments.html","a":"section-47","t":"Knihovna Gonum › Čtení a modifikace prvků vektoru","s":"Druhá metoda se jmenuje AtVec a předává se jí jen jediný index. Použitelná je tedy jen v případě jednorozměrných vektorů."},{"p":"gonum_output_as_comments.html","a":"section-48","t":"Knihovna Gonum › Další podporované operace nad vektory","s":"Další podporované operace nad vektory V této podkapitole si popíšeme některé další operace, které lze provádět s vektory. Nejdříve vytvoříme dvojici vektorů, kt…"},{"p":"gonum_output_as_comments.html","a":"section-49","t":"Knihovna Gonum › Další podporované operace nad vektory","s":"Třetí vektor bude použit jako cíl pro některé vybrané operace"},{"p":"gonum_output_as_comments.html","a":"section-50","t":"Knihovna Gonum › Součet vektorů","s":"Součet vektorů Operace součtu dvou vektorů realizovaná metodou AddVec . V tomto případě se modifikuje její příjemce ( receiver )"},{"p":"gonum_output_as_comments.html","a":"section-51","t":"Knihovna Gonum › Součet vektorů","s":"Součet vektoru v2 se sebou samým s uložením výsledku do vektoru v"},{"p":"gonum_output_as_comments.html","a":"section-52","t":"Knihovna Gonum › Rozdíl vektorů","s":"Rozdíl vektorů Operace rozdílu vektorů, opět s modifikací příjemce"},{"p":"gonum_output_as_comments.html","a":"section-53","t":"Knihovna Gonum › Změna měřítka (natažení...)","s":"Změna měřítka (natažení...) Změna měřítka, tj. vynásobení všech prvků vektoru nějakou konstantou, se realizuje metodou nazvanou ScaleVec"},{"p":"gonum_output_as_comments.html","a":"section-54","t":"Knihovna Gonum › Vynásobení korespondujících prvků vektorů","s":"Vynásobení korespondujících prvků vektorů Vynásobení dvou vektorů stylem prvek po prvku (nejedná se o vektorový součin)"},{"p":"gonum_output_as_comments.html","a":"section-55","t":"Knihovna Gonum › Součin matice a vektoru","s":"Součin matice a vektoru Podporována je i operace vynásobení matice a vektoru, samozřejmě za předpokladu, že počet sloupců matice bude odpovídat počtu řádků slou…"},{"p":"gonum_output_as_comments.html","a":"section-56","t":"Knihovna Gonum › Součin matice a vektoru","s":"Vynásobení vektoru maticí reprezentující otočení okolo z-ové osy o 90 stupňů by mohlo být realizováno následujícím kódem"},{"p":"gonum_output_as_comments.html","a":"section-57","t":"Knihovna Gonum › Skalární součin","s":"Skalární součin Skalární součin dvou vektorů o stejné velikosti se provádí funkcí Dot . Výsledkem je hodnota typu float64 , tedy skutečně skalár."},{"p":"gonum_output_as_comments.html","a":"section-58","t":"Knihovna Gonum › Skalární součin","s":"Získání prvku s největší a nejmenší hodnotou:"},{"p":"gonum_output_as_comments.html","a":"section-59","t":"Knihovna Gonum › Skalární součin","s":"Součet všech prvků vektoru:"},{"p":"gonum_output_as_comments.html","a":"section-60","t":"Knihovna Gonum › Práce s obecnými dvourozměrnými maticemi","s":"Práce s obecnými dvourozměrnými maticemi Obecnou dvourozměrnou matici vytváříme konstruktorem NewDense , které se předá počet řádků následovaný počtem sloupců"},{"p":"gonum_output_as_comments.html","a":"section-61","t":"Knihovna Gonum › Práce s obecnými dvourozměrnými maticemi","s":"Konstrukce matice s inicializací jejich prvků se provede předáním řezu s hodnotami prvků"},{"p":"gonum_output_as_comments.html","a":"section-62","t":"Knihovna Gonum › Práce s obecnými dvourozměrnými maticemi","s":"Třetí matice, tentokrát se třemi řádky a čtyřmi sloupci"},{"p":"gonum_output_as_comments.html","a":"section-63","t":"Knihovna Gonum › Práce s obecnými dvourozměrnými maticemi","s":"Čtvercová matice 3x3 prvky"},{"p":"gonum_output_as_comments.html","a":"section-64","t":"Knihovna Gonum › Přečtení sloupce z matice","s":"Přečtení sloupce z matice Přečtení i-tého sloupce matice zajišťuje metoda Col . Výsledkem je v tomto případě běžný řez programovacího jazyka Go"},{"p":"gonum_output_as_comments.html","a":"section-65","t":"Knihovna Gonum › Přečtení řádku z matice","s":"Přečtení řádku z matice Přečtení j-tého řádku matice je provedeno metodou Row . Výsledkem je v tomto případě opět běžný řez programovacího jazyka Go (toto chová…"},{"p":"gonum_output_as_comments.html","a":"section-66","t":"Knihovna Gonum › Výpočet determinantu","s":"Výpočet determinantu O výpočet determinantu matice 3x3 prvky se stará metoda nazvaná Det . V tomto případě je výsledkem skalární hodnota typu float64"},{"p":"gonum_output_as_comments.html","a":"section-67","t":"Knihovna Gonum › Prvek s minimální a maximální hodnotou, součet hodnot prvků","s":"Prvek s minimální a maximální hodnotou, součet hodnot prvků Opět můžeme použít funkce pro získání prvku s nejmenší hodnotou, největší hodnotou a pro součet (sum…"},{"p":"gonum_output_as_comments.html","a":"section-68","t":"Knihovna Gonum › Získání diagonální matice","s":"Získání diagonální matice Poslední zajímavou metodou určenou pro zpracování matic je metoda, která vrací diagonální matici (všechny prvky kromě prvků na hlavní …"},{"p":"gonum_output_as_comments.html","a":"section-69","t":"Knihovna Gonum › Symetrické matice","s":"Symetrické matice V knihovně mat existuje i konstruktor pro symetrické matice. Chování tohoto konstruktoru je ovšem poněkud zvláštní - předat je mu totiž nutné …"},{"p":"gonum_output_as_comments.html","a":"section-70","t":"Knihovna Gonum › Symetrické matice","s":"Symetrické matice zachovávají většinu základních vlastností běžných matic, tj. můžeme například získat informace o jejich kapacitě, velikosti (v jednotlivých di…"},{"p":"gonum_output_as_comments.html","a":"section-71","t":"Knihovna Gonum › Symetrické matice","s":"Vytvořit je možné i transformovanou matici, což je ovšem jen kopie matice původní:"},{"p":"gonum_output_as_comments.html","a":"section-72","t":"Knihovna Gonum › Symetrické matice","s":"Prvky symetrické matice se nastavují metodou SetSym (jiná metoda ostatně ani není k dispozici). Tato metoda pochopitelně zachovává \"symetričnost\" matice, tj. zm…"},{"p":"gonum_output_as_comments.html","a":"section-73","t":"Knihovna Gonum › Diagonální matice","s":"Diagonální matice Další variantou matic jsou diagonální matice. Ty lze vytvořit konstruktorem NewDiagDense"},{"p":"gonum_output_as_comments.html","a":"section-74","t":"Knihovna Gonum › Diagonální matice","s":"Konstruktoru je možné předat hodnoty všech prvků na hlavní diagonále:"},{"p":"gonum_output_as_comments.html","a":"section-75","t":"Knihovna Gonum › Diagonální matice","s":"A opět jsou k dispozici metody pro získání základních informací o existující matici"},{"p":"gonum_output_as_comments.html","a":"section-76","t":"Knihovna Gonum › Diagonální matice","s":"Pro nastavení hodnoty prvku diagonální matice se používá metoda nazvaná SetDiag"},{"p":"gonum_output_as_comments.html","a":"section-77","t":"Knihovna Gonum › Trojúhelníkové matice","s":"Trojúhelníkové matice V knihovně mat jsou vývojářům k dispozici i funkce a metody určené pro práci s trojúhelníkovými maticemi. Opět si nejprve řekněme, jakým z…"},{"p":"gonum_output_as_comments.html","a":"section-78","t":"Knihovna Gonum › Trojúhelníkové matice","s":"Dolní trojúhelníková matice inicializovaná shodnými hodnotami se konstruuje následovně"},{"p":"gonum_output_as_comments.html","a":"section-79","t":"Knihovna Gonum › Trojúhelníkové matice","s":"Získat můžeme pohled obsahující pouze prvky na hlavní diagonále:"},{"p":"gonum_output_as_comments.html","a":"section-80","t":"Knihovna Gonum › Trojúhelníkové matice","s":"Trojúhelníkové matice lze transponovat, čímž se z horní matice stane dolní a naopak"},{"p":"gonum_output_as_comments.html","a":"section-81","t":"Knihovna Gonum › Trojúhelníkové matice","s":"Pro nastavení hodnot prvků trojúhelníkové matice slouží metoda NewTriDense , která zajistí, aby se neměnily prvky v té části trojúhelníkové matice, které musí b…"},{"p":"gonum_output_as_comments.html","a":"section-82","t":"Knihovna Gonum › Trojúhelníkové matice","s":"toto provést nelze nelze: t3.SetTri(2, 0, 100) vedlo by k chybě při běhu: mat: triangular set out of bounds Prvek ve třetím sloupci a na prvním řádku naopak změ…"},{"p":"gonum_output_as_comments.html","a":"section-83","t":"Knihovna Gonum › finito █","s":"Další informace o datových typech, metodách a funkcích poskytovaných balíčkem mat naleznete na stránce https://godoc.org/gonum.org/v1/gonum/mat (https://godoc.o…"},{"p":"gonum_output_as_comments.html","a":"section-84","t":"Knihovna Gonum › finito █","s":"Odkazy pro další studium: 1. The Gonum Numerical Computing Package (https://www.gonum.org/post/introtogonum/) 1. Gorilla REPL: interaktivní prostředí pro progra…"},{"p":"gonum_spy.html","a":"section-0","t":"Knihovna Gonum: zobrazení struktury řídkých matic › Knihovna Gonum: zobrazení struktury řídkých matic","s":"//go:build example"},{"p":"gonum_spy.html","a":"section-1","t":"Knihovna Gonum: zobrazení struktury řídkých matic › Úvodní informace","s":"Knihovna Gonum: zobrazení struktury řídkých matic Úvodní informace V základním textu o knihovně Gonum jsme si ukázali, že tisk velké matice o rozměrech 100x100 …"},{"p":"gonum_spy.html","a":"section-2","t":"Knihovna Gonum: zobrazení struktury řídkých matic › Úvodní informace","s":"Kromě balíčků fmt a mat budeme potřebovat i balíčky pro práci s řetězci a se soubory (SVG obrázky se ukládají na disk):"},{"p":"gonum_spy.html","a":"section-3","t":"Knihovna Gonum: zobrazení struktury řídkých matic › Mřížka se vzorkem nenulových prvků","s":"Mřížka se vzorkem nenulových prvků Všechny tři způsoby zobrazení sdílí stejný základ - mřížku logických hodnot, v níž true znamená, že v odpovídající oblasti ma…"},{"p":"gonum_spy.html","a":"section-4","t":"Knihovna Gonum: zobrazení struktury řídkých matic › Mřížka se vzorkem nenulových prvků","s":"Při nulové (nebo dokonce záporné) šířce či výšce je mřížka prázdná"},{"p":"gonum_spy.html","a":"section-5","t":"Knihovna Gonum: zobrazení struktury řídkých matic › Mřížka se vzorkem nenulových prvků","s":"Každý prvek matice je namapován do právě jednoho bodu mřížky"},{"p":"gonum_spy.html","a":"section-6","t":"Knihovna Gonum: zobrazení struktury řídkých matic › Mřížka se vzorkem nenulových prvků","s":"Počet nenulových prvků ( nnz , number of non-zeros ) vypíšeme v hlavičce každého zobrazení, podobně jako mat.Formatted vypisuje rozměry matice"},{"p":"gonum_spy.html","a":"section-7","t":"Knihovna Gonum: zobrazení struktury řídkých matic › Zobrazení s využitím Braillova písma","s":"Zobrazení s využitím Braillova písma Znaky Braillova písma jsou v Unicode uloženy od kódu U+2800 a každý znak obsahuje mřížku 2x4 bodů. Každému bodu odpovídá je…"},{"p":"gonum_spy.html","a":"section-8","t":"Knihovna Gonum: zobrazení struktury řídkých matic › Zobrazení s využitím Braillova písma","s":"Prázdnou mřížku nelze zobrazit, vypíše se tedy jen hlavička"},{"p":"gonum_spy.html","a":"section-9","t":"Knihovna Gonum: zobrazení struktury řídkých matic › Zobrazení s využitím blokových znaků","s":"Zobrazení s využitím blokových znaků Ne všechny terminálové fonty obsahují znaky Braillova písma. Bezpečnější (i když méně podrobnou) alternativou jsou znaky ▀ …"},{"p":"gonum_spy.html","a":"section-10","t":"Knihovna Gonum: zobrazení struktury řídkých matic › Export do formátu SVG","s":"Export do formátu SVG Pro dokumentaci je výhodnější vektorový obrázek. Každý bod mřížky s nenulovými prvky je v něm reprezentován čtverečkem (obdélníkem) o veli…"},{"p":"gonum_spy.html","a":"section-11","t":"Knihovna Gonum: zobrazení struktury řídkých matic › Export do formátu SVG","s":"Poměr stran obrázku odpovídá poměru stran matice"},{"p":"gonum_spy.html","a":"section-12","t":"Knihovna Gonum: zobrazení struktury řídkých matic › Export do formátu SVG","s":"Prázdná mřížka nemá žádné body, obrázek pak obsahuje jen rámeček"},{"p":"gonum_spy.html","a":"section-13","t":"Knihovna Gonum: zobrazení struktury řídkých matic › Export do formátu SVG","s":"Obrázky budeme ukládat do adresáře s vygenerovanou dokumentací, odkud jsou přímo dostupné relativní cestou. Nástroj weave předává jméno tohoto adresáře v proměn…"},{"p":"gonum_spy.html","a":"section-14","t":"Knihovna Gonum: zobrazení struktury řídkých matic › Export do formátu SVG","s":"Obrázek se spy grafem pak již jen zapíšeme do souboru:"},{"p":"gonum_spy.html","a":"section-15","t":"Knihovna Gonum: zobrazení struktury řídkých matic › Export do formátu SVG","s":"Všechny další příkazy opět umístíme do funkce main :"},{"p":"gonum_spy.html","a":"section-16","t":"Knihovna Gonum: zobrazení struktury řídkých matic › Diagonální matice","s":"Diagonální matice Začneme stejnou maticí, jako v základním textu - jednotkovou maticí o rozměrech 100x100 prvků:"},{"p":"gonum_spy.html","a":"section-17","t":"Knihovna Gonum: zobrazení struktury řídkých matic › Diagonální matice","s":"Matici zobrazíme s využitím Braillova písma na ploše 20x10 znaků. Na jeden znak tedy připadá blok deseti řádků a pěti sloupců matice (ve čtyřech řádcích a dvou …"},{"p":"gonum_spy.html","a":"section-18","t":"Knihovna Gonum: zobrazení struktury řídkých matic › Diagonální matice","s":"Hlavní diagonála je nyní na první pohled patrná:"},{"p":"gonum_spy.html","a":"section-19","t":"Knihovna Gonum: zobrazení struktury řídkých matic › Diagonální matice","s":"Stejnou matici si uložíme i ve formátu SVG:"},{"p":"gonum_spy.html","a":"section-20","t":"Knihovna Gonum: zobrazení struktury řídkých matic › Pásová matice","s":"! Jednotková matice 100x100 (spy big.svg) Pásová matice Pásová ( band ) matice obsahuje nenulové prvky pouze na hlavní diagonále a v jejím blízkém okolí. Knihov…"},{"p":"gonum_spy.html","a":"section-21","t":"Knihovna Gonum: zobrazení struktury řídkých matic › Pásová matice","s":"Pro změnu použijeme blokové znaky:"},{"p":"gonum_spy.html","a":"section-22","t":"Knihovna Gonum: zobrazení struktury řídkých matic › Pásová matice","s":"Výsledkem je \"tlustší\" diagonála:"},{"p":"gonum_spy.html","a":"section-23","t":"Knihovna Gonum: zobrazení struktury řídkých matic › Trojúhelníková matice","s":"! Pásová matice 60x60 (spy band.svg) Trojúhelníková matice Podobně snadno rozpoznáme i trojúhelníkovou matici. Dolní trojúhelníkovou matici 40x40 naplníme jedni…"},{"p":"gonum_spy.html","a":"section-24","t":"Knihovna Gonum: zobrazení struktury řídkých matic › Trojúhelníková matice","s":"Výsledek:"},{"p":"gonum_spy.html","a":"section-25","t":"Knihovna Gonum: zobrazení struktury řídkých matic › Malé matice","s":"! Dolní trojúhelníková matice 40x40 (spy tri.svg) Malé matice Pokud je matice menší než plocha určená pro zobrazení, podvzorkování se neprovádí a každému prvku …"},{"p":"gonum_spy.html","a":"section-26","t":"Knihovna Gonum: zobrazení struktury řídkých matic › Malé matice","s":"Výsledek (první řádek tvoří horní polovinu znaků, druhý řádek dolní polovinu, třetí řádek opět horní polovinu dalšího řádku znaků):"},{"p":"gonum_spy.html","a":"section-27","t":"Knihovna Gonum: zobrazení struktury řídkých matic › Malé matice","s":"Při nulové šířce nebo výšce zobrazení není co vykreslit, vypíše se tedy pouze hlavička:"},{"p":"gonum_spy.html","a":"section-28","t":"Knihovna Gonum: zobrazení struktury řídkých matic › finito █","s":"finito █"},{"p":"gonum_heatmap.html","a":"section-0","t":"Knihovna Gonum: zobrazení matic formou teplotní mapy › Knihovna Gonum: zobrazení matic formou teplotní mapy","s":"//go:build example"},{"p":"gonum_heatmap.html","a":"section-1","t":"Knihovna Gonum: zobrazení matic formou teplotní mapy › Úvodní informace","s":"Knihovna Gonum: zobrazení matic formou teplotní mapy Úvodní informace Mezi odkazy uvedenými na konci základního textu o knihovně Gonum je zmíněn i projekt gonum…"},{"p":"gonum_heatmap.html","a":"section-2","t":"Knihovna Gonum: zobrazení matic formou teplotní mapy › Úvodní informace","s":"Tentokrát budeme potřebovat větší množství balíčků - kromě balíčku mat i balíček stat (výpočet kovarianční matice) a několik balíčků z projektu gonum/plot :"},{"p":"gonum_heatmap.html","a":"section-3","t":"Knihovna Gonum: zobrazení matic formou teplotní mapy › Adaptér mezi maticí a teplotní mapou","s":"Adaptér mezi maticí a teplotní mapou Teplotní mapa z balíčku plotter neočekává přímo matici, ale hodnotu splňující rozhraní plotter.GridXYZ . To vyžaduje metody…"},{"p":"gonum_heatmap.html","a":"section-4","t":"Knihovna Gonum: zobrazení matic formou teplotní mapy › Vykreslení teplotní mapy s barevnou škálou","s":"Vykreslení teplotní mapy s barevnou škálou Samotná funkce pro vykreslení je nejdelší částí tohoto příkladu. Teplotní mapa a barevná škála jsou vykresleny do dvo…"},{"p":"gonum_heatmap.html","a":"section-5","t":"Knihovna Gonum: zobrazení matic formou teplotní mapy › Vykreslení teplotní mapy s barevnou škálou","s":"Rozsah barevné škály musí odpovídat rozsahu hodnot v matici"},{"p":"gonum_heatmap.html","a":"section-6","t":"Knihovna Gonum: zobrazení matic formou teplotní mapy › Vykreslení teplotní mapy s barevnou škálou","s":"Plátno rozdělíme na dvě části - teplotní mapa zabere větší část plochy, barevná škála zbytek"},{"p":"gonum_heatmap.html","a":"section-7","t":"Knihovna Gonum: zobrazení matic formou teplotní mapy › Vykreslení teplotní mapy s barevnou škálou","s":"Obrázky ukládáme stejně jako v textu o zobrazení struktury řídkých matic (gonum spy.html), tedy do adresáře s vygenerovanou dokumentací:"},{"p":"gonum_heatmap.html","a":"section-8","t":"Knihovna Gonum: zobrazení matic formou teplotní mapy › Vykreslení teplotní mapy s barevnou škálou","s":"Všechny další příkazy opět umístíme do funkce main :"},{"p":"gonum_heatmap.html","a":"section-9","t":"Knihovna Gonum: zobrazení matic formou teplotní mapy › Velká řídká matice","s":"Velká řídká matice Začneme opět jednotkovou maticí o rozměrech 100x100 prvků, do které pro zajímavost přidáme i několik prvků s odlišnými hodnotami:"},{"p":"gonum_heatmap.html","a":"section-10","t":"Knihovna Gonum: zobrazení matic formou teplotní mapy › Velká řídká matice","s":"Teplotní mapu uložíme ve formátu PNG, protože ve formátu SVG by obsahovala samostatný obdélník pro každý z deseti tisíc prvků:"},{"p":"gonum_heatmap.html","a":"section-11","t":"Knihovna Gonum: zobrazení matic formou teplotní mapy › Výsledek maticového součinu","s":"! Teplotní mapa matice big (heatmap big.png) Výsledek maticového součinu Dále zobrazíme výsledek maticového součinu d.Mul(m2, m3) ze základního textu:"},{"p":"gonum_heatmap.html","a":"section-12","t":"Knihovna Gonum: zobrazení matic formou teplotní mapy › Výsledek maticového součinu","s":"Připomeňme si, že výsledkem je symetrická matice:"},{"p":"gonum_heatmap.html","a":"section-13","t":"Knihovna Gonum: zobrazení matic formou teplotní mapy › Výsledek maticového součinu","s":"Tentokrát si obrázek uložíme ve formátu PNG:"},{"p":"gonum_heatmap.html","a":"section-14","t":"Knihovna Gonum: zobrazení matic formou teplotní mapy › Kovarianční matice","s":"! Teplotní mapa výsledku maticového součinu (heatmap mul.png) Kovarianční matice Teplotní mapy se velmi často používají pro zobrazení kovariančních nebo korelač…"},{"p":"gonum_heatmap.html","a":"section-15","t":"Knihovna Gonum: zobrazení matic formou teplotní mapy › Kovarianční matice","s":"Kovarianční matice je symetrická, proto ji uložíme do matice typu SymDense :"},{"p":"gonum_heatmap.html","a":"section-16","t":"Knihovna Gonum: zobrazení matic formou teplotní mapy › Kovarianční matice","s":"Výsledek:"},{"p":"gonum_heatmap.html","a":"section-17","t":"Knihovna Gonum: zobrazení matic formou teplotní mapy › finito █","s":"! Teplotní mapa kovarianční matice (heatmap cov.svg) Na první pohled je patrné, že první dvě veličiny jsou silně korelované (druhý sloupec je dvojnásobkem první…"},{"p":"gonum_matio.html","a":"section-0","t":"Knihovna Gonum: načítání a ukládání matic › Knihovna Gonum: načítání a ukládání matic","s":"//go:build example"},{"p":"gonum_matio.html","a":"section-1","t":"Knihovna Gonum: načítání a ukládání matic › Úvodní informace","s":"Knihovna Gonum: načítání a ukládání matic Úvodní informace Ve všech předchozích příkladech byly prvky matic zapsány přímo ve zdrojovém kódu formou řezu float64{…"},{"p":"gonum_matio.html","a":"section-2","t":"Knihovna Gonum: načítání a ukládání matic › Úvodní informace","s":"Kromě balíčků fmt a mat použijeme i balíček matio a několik balíčků ze standardní knihovny:"},{"p":"gonum_matio.html","a":"section-3","t":"Knihovna Gonum: načítání a ukládání matic › Úvodní informace","s":"Všechny další příkazy opět umístíme do funkce main :"},{"p":"gonum_matio.html","a":"section-4","t":"Knihovna Gonum: načítání a ukládání matic › Formát CSV","s":"Formát CSV Začneme formátem CSV. Matici načteme přímo z řetězce, ovšem namísto něj lze pochopitelně použít i otevřený soubor. Druhým parametrem funkce ReadCSV u…"},{"p":"gonum_matio.html","a":"section-5","t":"Knihovna Gonum: načítání a ukládání matic › Formát CSV","s":"Výsledkem je matice se čtyřmi řádky a třemi sloupci:"},{"p":"gonum_matio.html","a":"section-6","t":"Knihovna Gonum: načítání a ukládání matic › Formát CSV","s":"Zápis do formátu CSV je stejně snadný. Zapíšeme transponovanou matici, tentokrát bez hlavičky:"},{"p":"gonum_matio.html","a":"section-7","t":"Knihovna Gonum: načítání a ukládání matic › Formát CSV","s":"S výsledkem:"},{"p":"gonum_matio.html","a":"section-8","t":"Knihovna Gonum: načítání a ukládání matic › Formát MatrixMarket","s":"Formát MatrixMarket Formát MatrixMarket rozlišuje dva způsoby uložení matic. Ve formátu array jsou uloženy všechny prvky matice (po sloupcích), ve formátu coord…"},{"p":"gonum_matio.html","a":"section-9","t":"Knihovna Gonum: načítání a ukládání matic › Formát MatrixMarket","s":"Prvek na souřadnicích (1, 2) byl doplněn automaticky:"},{"p":"gonum_matio.html","a":"section-10","t":"Knihovna Gonum: načítání a ukládání matic › Formát MatrixMarket","s":"Obecná matice v souřadnicovém formátu je načtena do řídké matice typu matio.Sparse . Knihovna Gonum sice řídké matice přímo nepodporuje, ovšem tento typ impleme…"},{"p":"gonum_matio.html","a":"section-11","t":"Knihovna Gonum: načítání a ukládání matic › Formát MatrixMarket","s":"Výsledek:"},{"p":"gonum_matio.html","a":"section-12","t":"Knihovna Gonum: načítání a ukládání matic › Formát MatrixMarket","s":"Při zápisu je formát zvolen podle typu matice. Symetrická matice bude uložena ve formátu array , ovšem pouze prvky v dolním trojúhelníku:"},{"p":"gonum_matio.html","a":"section-13","t":"Knihovna Gonum: načítání a ukládání matic › Formát MatrixMarket","s":"Výsledek:"},{"p":"gonum_matio.html","a":"section-14","t":"Knihovna Gonum: načítání a ukládání matic › Formát NumPy","s":"Formát NumPy Pro výměnu dat s nástroji naprogramovanými v Pythonu je nejvýhodnější použít přímo nativní formát knihovny NumPy . Soubory .npy obsahují jediné pol…"},{"p":"gonum_matio.html","a":"section-15","t":"Knihovna Gonum: načítání a ukládání matic › Formát NumPy","s":"A následně ji opět načteme:"},{"p":"gonum_matio.html","a":"section-16","t":"Knihovna Gonum: načítání a ukládání matic › Formát NumPy","s":"Obě matice jsou shodné:"},{"p":"gonum_matio.html","a":"section-17","t":"Knihovna Gonum: načítání a ukládání matic › Formát NumPy","s":"Jednorozměrná pole jsou načtena do vektorů typu VecDense :"},{"p":"gonum_matio.html","a":"section-18","t":"Knihovna Gonum: načítání a ukládání matic › Formát NumPy","s":"Výsledek:"},{"p":"gonum_matio.html","a":"section-19","t":"Knihovna Gonum: načítání a ukládání matic › Formát NumPy","s":"Archiv .npz (vytvářený funkcemi numpy.savez a numpy.savez compressed ) obsahuje větší množství pojmenovaných polí:"},{"p":"gonum_matio.html","a":"section-20","t":"Knihovna Gonum: načítání a ukládání matic › Formát NumPy","s":"Výsledek:"},{"p":"gonum_matio.html","a":"section-21","t":"Knihovna Gonum: načítání a ukládání matic › finito █","s":"finito █"},{"p":"gonum_optimize.html","a":"section-0","t":"Knihovna Gonum: hledání minima funkce (balíček optimize) › Knihovna Gonum: hledání minima funkce (balíček optimize)","s":"//go:build example"},{"p":"gonum_optimize.html","a":"section-1","t":"Knihovna Gonum: hledání minima funkce (balíček optimize) › Úvodní informace","s":"Knihovna Gonum: hledání minima funkce (balíček optimize) Úvodní informace V předchozích částech jsme se zabývali prakticky výhradně balíčkem mat , tedy maticemi…"},{"p":"gonum_optimize.html","a":"section-2","t":"Knihovna Gonum: hledání minima funkce (balíček optimize) › Úvodní informace","s":"Kromě balíčků fmt a mat budeme potřebovat i balíček optimize :"},{"p":"gonum_optimize.html","a":"section-3","t":"Knihovna Gonum: hledání minima funkce (balíček optimize) › Rosenbrockova funkce","s":"Rosenbrockova funkce Klasickým testem optimalizačních metod je Rosenbrockova funkce dvou proměnných f(x, y) = (1 - x)² + 100 (y - x²)² . Její minimum leží v bod…"},{"p":"gonum_optimize.html","a":"section-4","t":"Knihovna Gonum: hledání minima funkce (balíček optimize) › Rosenbrockova funkce","s":"Gradient (tedy vektor parciálních derivací) se nevrací, ale zapisuje do předaného řezu. Díky tomu se při každém volání nemusí alokovat paměť:"},{"p":"gonum_optimize.html","a":"section-5","t":"Knihovna Gonum: hledání minima funkce (balíček optimize) › Rosenbrockova funkce","s":"Všechny další příkazy umístíme do funkce main :"},{"p":"gonum_optimize.html","a":"section-6","t":"Knihovna Gonum: hledání minima funkce (balíček optimize) › Metoda BFGS","s":"Metoda BFGS Funkci i její gradient předáme ve struktuře Problem . Minimum budeme hledat z tradičního počátečního bodu -1.2, 1 . Třetím parametrem funkce Minimiz…"},{"p":"gonum_optimize.html","a":"section-7","t":"Knihovna Gonum: hledání minima funkce (balíček optimize) › Metoda BFGS","s":"Nalezené minimum skutečně leží v bodě 1, 1 , hodnota funkce je (až na zaokrouhlovací chyby) nulová. Stav GradientThreshold znamená, že výpočet skončil proto, že…"},{"p":"gonum_optimize.html","a":"section-8","t":"Knihovna Gonum: hledání minima funkce (balíček optimize) › Metoda BFGS","s":"Výsledek obsahuje i statistiky o průběhu výpočtu - počet iterací a počet výpočtů hodnoty funkce a jejího gradientu:"},{"p":"gonum_optimize.html","a":"section-9","t":"Knihovna Gonum: hledání minima funkce (balíček optimize) › Metoda BFGS","s":"Výsledek:"},{"p":"gonum_optimize.html","a":"section-10","t":"Knihovna Gonum: hledání minima funkce (balíček optimize) › Porovnání metod BFGS, LBFGS a Nelder-Mead","s":"Porovnání metod BFGS, LBFGS a Nelder-Mead Metody jsou představovány hodnotami typů implementujících rozhraní Method , takže je můžeme uložit do řezu a postupně …"},{"p":"gonum_optimize.html","a":"section-11","t":"Knihovna Gonum: hledání minima funkce (balíček optimize) › Porovnání metod BFGS, LBFGS a Nelder-Mead","s":"Všechny tři metody nalezly stejné minimum. Metoda LBFGS potřebovala nejmenší počet výpočtů funkce, metoda Nelder-Mead naopak potřebovala přibližně desetkrát víc…"},{"p":"gonum_optimize.html","a":"section-12","t":"Knihovna Gonum: hledání minima funkce (balíček optimize) › Metoda nejmenších čtverců","s":"Metoda nejmenších čtverců Minimalizovat můžeme i funkce, které jsou vyjádřeny pomocí matic a vektorů. Typickým příkladem je metoda nejmenších čtverců, kdy pro p…"},{"p":"gonum_optimize.html","a":"section-13","t":"Knihovna Gonum: hledání minima funkce (balíček optimize) › Metoda nejmenších čtverců","s":"Funkce i její gradient 2 Aᵀ(A x - b) se vypočtou operacemi nad maticemi a vektory. Vektor reziduí r je sdílen oběma funkcemi, aby se nemusel pro každé volání al…"},{"p":"gonum_optimize.html","a":"section-14","t":"Knihovna Gonum: hledání minima funkce (balíček optimize) › Metoda nejmenších čtverců","s":"Matice A je špatně podmíněná, takže výchozí mez velikosti gradientu ( 1e-12 ) je příliš přísná a hledání by skončilo chybou při hledání na přímce (line search).…"},{"p":"gonum_optimize.html","a":"section-15","t":"Knihovna Gonum: hledání minima funkce (balíček optimize) › Metoda nejmenších čtverců","s":"Výsledky:"},{"p":"gonum_optimize.html","a":"section-16","t":"Knihovna Gonum: hledání minima funkce (balíček optimize) › Metoda nejmenších čtverců","s":"Pro tuto úlohu ovšem optimalizaci vlastně nepotřebujeme. Metoda SolveVec totiž pro nečtvercové matice vrací právě řešení ve smyslu nejmenších čtverců (vypočtené…"},{"p":"gonum_optimize.html","a":"section-17","t":"Knihovna Gonum: hledání minima funkce (balíček optimize) › Metoda nejmenších čtverců","s":"Výsledek:"},{"p":"gonum_optimize.html","a":"section-18","t":"Knihovna Gonum: hledání minima funkce (balíček optimize) › finito █","s":"finito █"},{"p":"gonum_integrate.html","a":"section-0","t":"Knihovna Gonum: numerická integrace a interpolace › Knihovna Gonum: numerická integrace a interpolace","s":"//go:build example"},{"p":"gonum_integrate.html","a":"section-1","t":"Knihovna Gonum: numerická integrace a interpolace › Úvodní informace","s":"Knihovna Gonum: numerická integrace a interpolace Úvodní informace Velmi často se setkáme s funkcemi, které nejsou zadány vzorcem, ale pouze svými hodnotami v n…"},{"p":"gonum_integrate.html","a":"section-2","t":"Knihovna Gonum: numerická integrace a interpolace › Úvodní informace","s":"Kromě balíčků fmt , math a mat použijeme balíčky integrate , quad a interp a také balíček floats s pomocnými funkcemi pro práci s řezy:"},{"p":"gonum_integrate.html","a":"section-3","t":"Knihovna Gonum: numerická integrace a interpolace › Úvodní informace","s":"Všechny další příkazy opět umístíme do funkce main :"},{"p":"gonum_integrate.html","a":"section-4","t":"Knihovna Gonum: numerická integrace a interpolace › Vzorky funkce","s":"Vzorky funkce Jako body, v nichž známe hodnoty funkcí, použijeme vektor s hodnotami 1 až 10, se kterým jsme pracovali již v úvodní části. Funkce z balíčků integ…"},{"p":"gonum_integrate.html","a":"section-5","t":"Knihovna Gonum: numerická integrace a interpolace › Vzorky funkce","s":"První funkcí bude x² . Její hodnoty v zadaných bodech vypočteme vynásobením korespondujících prvků vektoru:"},{"p":"gonum_integrate.html","a":"section-6","t":"Knihovna Gonum: numerická integrace a interpolace › Vzorky funkce","s":"Výsledek:"},{"p":"gonum_integrate.html","a":"section-7","t":"Knihovna Gonum: numerická integrace a interpolace › Lichoběžníková a Simpsonova metoda","s":"Lichoběžníková a Simpsonova metoda Integrál funkce x² v intervalu od 1 do 10 je roven (10³ - 1³) / 3 = 333 . Lichoběžníková metoda nahrazuje funkci mezi sousedn…"},{"p":"gonum_integrate.html","a":"section-8","t":"Knihovna Gonum: numerická integrace a interpolace › Lichoběžníková a Simpsonova metoda","s":"Simpsonova metoda je pro polynomy do třetího stupně přesná, chyba je způsobena pouze zaokrouhlováním:"},{"p":"gonum_integrate.html","a":"section-9","t":"Knihovna Gonum: numerická integrace a interpolace › Lichoběžníková a Simpsonova metoda","s":"Zajímavější je funkce sin(x) , jejíž integrál v intervalu od 1 do 10 je roven cos(1) - cos(10) :"},{"p":"gonum_integrate.html","a":"section-10","t":"Knihovna Gonum: numerická integrace a interpolace › Lichoběžníková a Simpsonova metoda","s":"Deset vzorků je pro funkci sinus poměrně málo, obě metody se proto dopouštějí znatelné chyby:"},{"p":"gonum_integrate.html","a":"section-11","t":"Knihovna Gonum: numerická integrace a interpolace › Závislost chyby na počtu vzorků","s":"Závislost chyby na počtu vzorků Pokud zvětšíme počet vzorků, chyba se zmenší. Rovnoměrně rozmístěné body vytvoříme funkcí floats.Span , která naplní řez hodnota…"},{"p":"gonum_integrate.html","a":"section-12","t":"Knihovna Gonum: numerická integrace a interpolace › Závislost chyby na počtu vzorků","s":"Chyba lichoběžníkové metody klesá se čtvercem vzdálenosti bodů (při poloviční vzdálenosti je chyba čtyřikrát menší), chyba Simpsonovy metody se čtvrtou mocninou…"},{"p":"gonum_integrate.html","a":"section-13","t":"Knihovna Gonum: numerická integrace a interpolace › Integrace funkce zadané v Go","s":"Integrace funkce zadané v Go Pokud funkci umíme vypočítat v libovolném bodě, můžeme použít podbalíček quad . Funkce quad.Fixed integruje funkci s pevným počtem …"},{"p":"gonum_integrate.html","a":"section-14","t":"Knihovna Gonum: numerická integrace a interpolace › Integrace funkce zadané v Go","s":"Se stejným počtem výpočtů funkce, jaký jsme použili u lichoběžníkové metody, dostaneme výsledek přesný na deset desetinných míst:"},{"p":"gonum_integrate.html","a":"section-15","t":"Knihovna Gonum: numerická integrace a interpolace › Interpolace","s":"Interpolace Balíček interp obsahuje několik typů interpolace, které implementují rozhraní FittablePredictor . Metodou Fit se interpolace vypočte ze zadaných bod…"},{"p":"gonum_integrate.html","a":"section-16","t":"Knihovna Gonum: numerická integrace a interpolace › Interpolace","s":"V bodech ležících mezi vzorky se lineární interpolace od skutečné hodnoty funkce odchyluje více než splajn:"},{"p":"gonum_integrate.html","a":"section-17","t":"Knihovna Gonum: numerická integrace a interpolace › Interpolace","s":"Maximální chybu obou interpolací zjistíme porovnáním se skutečnou funkcí v jemné síti bodů. Funkce maxError akceptuje libovolný typ implementující rozhraní Pred…"},{"p":"gonum_integrate.html","a":"section-18","t":"Knihovna Gonum: numerická integrace a interpolace › Interpolace","s":"Výsledek:"},{"p":"gonum_integrate.html","a":"section-19","t":"Knihovna Gonum: numerická integrace a interpolace › Interpolace","s":"Splajn navíc umožňuje vypočítat i derivaci interpolované funkce. Derivací funkce sinus je kosinus:"},{"p":"gonum_integrate.html","a":"section-20","t":"Knihovna Gonum: numerická integrace a interpolace › Interpolace","s":"Výsledek:"},{"p":"gonum_integrate.html","a":"section-21","t":"Knihovna Gonum: numerická integrace a interpolace › finito █","s":"finito █"},{"p":"gonum_fourier.html","a":"section-0","t":"Knihovna Gonum: diskrétní Fourierova transformace › Knihovna Gonum: diskrétní Fourierova transformace","s":"//go:build example"},{"p":"gonum_fourier.html","a":"section-1","t":"Knihovna Gonum: diskrétní Fourierova transformace › Úvodní informace","s":"Knihovna Gonum: diskrétní Fourierova transformace Úvodní informace Při zpracování signálů nás často nezajímá průběh signálu v čase, ale jeho frekvenční spektrum…"},{"p":"gonum_fourier.html","a":"section-2","t":"Knihovna Gonum: diskrétní Fourierova transformace › Úvodní informace","s":"Kromě balíčků fmt , math a mat použijeme balíček fourier a balíček math/cmplx s funkcemi pro komplexní čísla:"},{"p":"gonum_fourier.html","a":"section-3","t":"Knihovna Gonum: diskrétní Fourierova transformace › Úvodní informace","s":"Koeficienty vypočtené transformací obsahují drobné zaokrouhlovací chyby (například 1e-16 namísto nuly), které by zbytečně znepřehledňovaly výpis. Amplitudy prot…"},{"p":"gonum_fourier.html","a":"section-4","t":"Knihovna Gonum: diskrétní Fourierova transformace › Úvodní informace","s":"Všechny další příkazy opět umístíme do funkce main :"},{"p":"gonum_fourier.html","a":"section-5","t":"Knihovna Gonum: diskrétní Fourierova transformace › Vzorkovaný signál","s":"Vzorkovaný signál Signál bude obsahovat 16 vzorků. Skládá se ze stejnosměrné složky s hodnotou 1, sinusovky s jednou periodou na 16 vzorků a sinusovky s polovič…"},{"p":"gonum_fourier.html","a":"section-6","t":"Knihovna Gonum: diskrétní Fourierova transformace › Vzorkovaný signál","s":"Transponovaný vektor se vypíše na jediný řádek:"},{"p":"gonum_fourier.html","a":"section-7","t":"Knihovna Gonum: diskrétní Fourierova transformace › Výpočet koeficientů","s":"Výpočet koeficientů Nejdříve vytvoříme objekt typu FFT pro zvolený počet vzorků. Ten si předpočítá hodnoty potřebné pro transformaci, takže ho lze použít opakov…"},{"p":"gonum_fourier.html","a":"section-8","t":"Knihovna Gonum: diskrétní Fourierova transformace › Výpočet koeficientů","s":"Koeficientů je pouze devět, nikoli šestnáct. Spektrum reálného signálu je totiž symetrické, takže koeficienty pro záporné frekvence není nutné počítat ani uklád…"},{"p":"gonum_fourier.html","a":"section-9","t":"Knihovna Gonum: diskrétní Fourierova transformace › Výpočet koeficientů","s":"Koeficienty jsou komplexní čísla. Jejich absolutní hodnota určuje amplitudu dané frekvence, argument pak její fázi. Amplitudy uložíme do vektoru a zobrazíme je …"},{"p":"gonum_fourier.html","a":"section-10","t":"Knihovna Gonum: diskrétní Fourierova transformace › Výpočet koeficientů","s":"Nenulové jsou pouze tři koeficienty odpovídající složkám signálu. Hodnoty nejsou normalizovány, stejnosměrná složka má tedy velikost n a sinusovky n/2 násobek s…"},{"p":"gonum_fourier.html","a":"section-11","t":"Knihovna Gonum: diskrétní Fourierova transformace › Výpočet koeficientů","s":"Frekvenci, která odpovídá koeficientu s daným indexem, vrací metoda Freq . Je vyjádřena jako podíl vzorkovací frekvence, maximální frekvencí je tedy polovina vz…"},{"p":"gonum_fourier.html","a":"section-12","t":"Knihovna Gonum: diskrétní Fourierova transformace › Výpočet koeficientů","s":"Výsledek:"},{"p":"gonum_fourier.html","a":"section-13","t":"Knihovna Gonum: diskrétní Fourierova transformace › Zpětná transformace","s":"Zpětná transformace Z koeficientů lze zpětnou transformací získat původní signál. Metoda Sequence ovšem vrací hodnoty vynásobené počtem vzorků, výsledný vektor …"},{"p":"gonum_fourier.html","a":"section-14","t":"Knihovna Gonum: diskrétní Fourierova transformace › Zpětná transformace","s":"Rozdíl mezi původním a obnoveným signálem je způsoben pouze zaokrouhlovacími chybami. Ověříme to výpočtem maximální absolutní hodnoty prvků rozdílového vektoru:"},{"p":"gonum_fourier.html","a":"section-15","t":"Knihovna Gonum: diskrétní Fourierova transformace › Zpětná transformace","s":"Výsledek:"},{"p":"gonum_fourier.html","a":"section-16","t":"Knihovna Gonum: diskrétní Fourierova transformace › Dolní propust","s":"Dolní propust Ve frekvenční oblasti se velmi snadno provádí filtrace. Dolní propust, která odstraní všechny frekvence vyšší než čtvrtina vzorkovací frekvence, j…"},{"p":"gonum_fourier.html","a":"section-17","t":"Knihovna Gonum: diskrétní Fourierova transformace › Dolní propust","s":"Zůstala pouze stejnosměrná složka a pomalejší sinusovka:"},{"p":"gonum_fourier.html","a":"section-18","t":"Knihovna Gonum: diskrétní Fourierova transformace › Dolní propust","s":"Po zpětné transformaci dostaneme signál bez rychlé sinusovky:"},{"p":"gonum_fourier.html","a":"section-19","t":"Knihovna Gonum: diskrétní Fourierova transformace › Dolní propust","s":"Výsledek:"},{"p":"gonum_fourier.html","a":"section-20","t":"Knihovna Gonum: diskrétní Fourierova transformace › finito █","s":"finito █"},{"p":"gonum_graph.html","a":"section-0","t":"Knihovna Gonum: grafy a matice sousednosti › Knihovna Gonum: grafy a matice sousednosti","s":"//go:build example"},{"p":"gonum_graph.html","a":"section-1","t":"Knihovna Gonum: grafy a matice sousednosti › Úvodní informace","s":"Knihovna Gonum: grafy a matice sousednosti Úvodní informace V úvodní části jsme si řekli, že knihovna Gonum obsahuje i podporu pro tvorbu grafů. Grafy (tedy mno…"},{"p":"gonum_graph.html","a":"section-2","t":"Knihovna Gonum: grafy a matice sousednosti › Úvodní informace","s":"Grafy jsou rozděleny do většího množství balíčků. Balíček simple obsahuje implementace grafů, algoritmy nalezneme v balíčcích path , topo a network a export do …"},{"p":"gonum_graph.html","a":"section-3","t":"Knihovna Gonum: grafy a matice sousednosti › Úvodní informace","s":"Hrany grafu budou představovat silnice mezi městy, váhou hrany je délka silnice. Vlastní typ hrany vznikne vložením typu simple.WeightedEdge . Metoda Attributes…"},{"p":"gonum_graph.html","a":"section-4","t":"Knihovna Gonum: grafy a matice sousednosti › Úvodní informace","s":"Attributes returns attributes of edge used by DOT encoder."},{"p":"gonum_graph.html","a":"section-5","t":"Knihovna Gonum: grafy a matice sousednosti › Úvodní informace","s":"Uzly vrácené algoritmy nejsou nijak seřazeny. Pro přehlednější (a hlavně stále stejný) výpis je seřadíme podle jejich identifikátorů:"},{"p":"gonum_graph.html","a":"section-6","t":"Knihovna Gonum: grafy a matice sousednosti › Úvodní informace","s":"Všechny další příkazy opět umístíme do funkce main :"},{"p":"gonum_graph.html","a":"section-7","t":"Knihovna Gonum: grafy a matice sousednosti › Matice sousednosti","s":"Matice sousednosti Začneme symetrickou maticí sousednosti grafu se sedmi uzly. Uzly 0 až 4 jsou propojeny několika hranami, uzly 5 a 6 jsou propojeny pouze navz…"},{"p":"gonum_graph.html","a":"section-8","t":"Knihovna Gonum: grafy a matice sousednosti › Převod matice na graf","s":"Převod matice na graf Neorientovaný graf s ohodnocenými hranami vytvoříme konstruktorem NewWeightedUndirectedGraph . Jeho parametry určují váhu hrany vedoucí z …"},{"p":"gonum_graph.html","a":"section-9","t":"Knihovna Gonum: grafy a matice sousednosti › Převod matice na graf","s":"Výsledek:"},{"p":"gonum_graph.html","a":"section-10","t":"Knihovna Gonum: grafy a matice sousednosti › Nejkratší cesty","s":"Nejkratší cesty Funkce path.DijkstraFrom vypočte nejkratší cesty ze zadaného uzlu do všech ostatních uzlů. Cestu do konkrétního uzlu a její délku (součet vah hr…"},{"p":"gonum_graph.html","a":"section-11","t":"Knihovna Gonum: grafy a matice sousednosti › Nejkratší cesty","s":"Do uzlu 3 nevede nejkratší cesta přímou hranou z uzlu 1 (délka 22), ale přes uzly 2 a 4. Do uzlů 5 a 6 žádná cesta nevede, proto je délka nekonečná:"},{"p":"gonum_graph.html","a":"section-12","t":"Knihovna Gonum: grafy a matice sousednosti › Komponenty souvislosti","s":"Komponenty souvislosti Že se graf skládá ze dvou vzájemně nepropojených částí, zjistíme i funkcí topo.ConnectedComponents . Ta vrací řez komponent, každá kompon…"},{"p":"gonum_graph.html","a":"section-13","t":"Knihovna Gonum: grafy a matice sousednosti › Komponenty souvislosti","s":"Výsledek:"},{"p":"gonum_graph.html","a":"section-14","t":"Knihovna Gonum: grafy a matice sousednosti › Převod grafu na matici","s":"Převod grafu na matici Opačný převod provedeme pomocí typu UndirectedMatrix , což je neorientovaný graf, jehož hrany jsou uloženy přímo v matici sousednosti. Pa…"},{"p":"gonum_graph.html","a":"section-15","t":"Knihovna Gonum: grafy a matice sousednosti › Převod grafu na matici","s":"Metoda Matrix vrací matici sousednosti. Pro neorientovaný graf se jedná o symetrickou matici typu SymDense , kterou můžeme porovnat s původní maticí:"},{"p":"gonum_graph.html","a":"section-16","t":"Knihovna Gonum: grafy a matice sousednosti › Převod grafu na matici","s":"Obě matice jsou shodné:"},{"p":"gonum_graph.html","a":"section-17","t":"Knihovna Gonum: grafy a matice sousednosti › PageRank","s":"PageRank Algoritmus PageRank ohodnocuje uzly orientovaného grafu podle toho, kolik hran do nich vede a jak důležité jsou uzly, ze kterých hrany vedou. Orientova…"},{"p":"gonum_graph.html","a":"section-18","t":"Knihovna Gonum: grafy a matice sousednosti › PageRank","s":"Funkci network.PageRank předáme graf, tlumicí faktor (obvykle se používá hodnota 0,85) a toleranci, při jejímž dosažení se iterativní výpočet ukončí. Výsledkem …"},{"p":"gonum_graph.html","a":"section-19","t":"Knihovna Gonum: grafy a matice sousednosti › PageRank","s":"Nejvyšší hodnocení má stránka 2, na kterou odkazují všechny ostatní stránky. Stránka 0 sice má jediný odkaz, ovšem z nejdůležitější stránky, zatímco na stránku …"},{"p":"gonum_graph.html","a":"section-20","t":"Knihovna Gonum: grafy a matice sousednosti › Export do formátu DOT","s":"Export do formátu DOT Grafy lze exportovat do formátu DOT, se kterým pracuje například nástroj Graphviz . Funkce dot.Marshal akceptuje graf, jeho jméno, prefix …"},{"p":"gonum_graph.html","a":"section-21","t":"Knihovna Gonum: grafy a matice sousednosti › Export do formátu DOT","s":"Uzly i hrany jsou seřazeny, hrany obsahují atribut label s vahou vrácený metodou Attributes :"},{"p":"gonum_graph.html","a":"section-22","t":"Knihovna Gonum: grafy a matice sousednosti › finito █","s":"Obrázek grafu pak vytvoříme příkazem dot -Tsvg roads.dot -o roads.svg . finito █"},{"p":"gonum_gota.html","a":"section-0","t":"Knihovna Gonum a datové rámce Gota › Knihovna Gonum a datové rámce Gota","s":"//go:build example"},{"p":"gonum_gota.html","a":"section-1","t":"Knihovna Gonum a datové rámce Gota › Úvodní informace","s":"Knihovna Gonum a datové rámce Gota Úvodní informace V úvodní části jsme si řekli, že pro práci s takzvanými \"datovými rámci\" se ve světě Pythonu používá knihovn…"},{"p":"gonum_gota.html","a":"section-2","t":"Knihovna Gonum a datové rámce Gota › Úvodní informace","s":"Kromě balíčku mat budeme potřebovat balíčky dataframe a series z knihovny Gota :"},{"p":"gonum_gota.html","a":"section-3","t":"Knihovna Gonum a datové rámce Gota › Úvodní informace","s":"Analyzovat budeme počty kusů ovoce prodané v šesti prodejnách. Data jsou uložena ve formátu CSV, první řádek obsahuje jména sloupců:"},{"p":"gonum_gota.html","a":"section-4","t":"Knihovna Gonum a datové rámce Gota › Úvodní informace","s":"Datový rámec převedeme na matici tak, že do každého sloupce matice zkopírujeme hodnoty ze sloupce datového rámce. Metoda Float vrací hodnoty sloupce jako řez fl…"},{"p":"gonum_gota.html","a":"section-5","t":"Knihovna Gonum a datové rámce Gota › Úvodní informace","s":"Všechny další příkazy opět umístíme do funkce main :"},{"p":"gonum_gota.html","a":"section-6","t":"Knihovna Gonum a datové rámce Gota › Načtení datového rámce","s":"Načtení datového rámce Datový rámec načteme funkcí dataframe.ReadCSV . Ta nevrací chybu jako druhou návratovou hodnotu, chyba je uložena v atributu Err datového…"},{"p":"gonum_gota.html","a":"section-7","t":"Knihovna Gonum a datové rámce Gota › Načtení datového rámce","s":"Datový rámec se vypíše ve formě tabulky, pod kterou jsou uvedeny typy sloupců:"},{"p":"gonum_gota.html","a":"section-8","t":"Knihovna Gonum a datové rámce Gota › Filtrace","s":"Filtrace Řádky vybereme metodou Filter , které předáme jméno sloupce, operátor porovnání a hodnotu, se kterou se porovnává. Vybereme prodejny, které prodaly víc…"},{"p":"gonum_gota.html","a":"section-9","t":"Knihovna Gonum a datové rámce Gota › Filtrace","s":"Výsledek:"},{"p":"gonum_gota.html","a":"section-10","t":"Knihovna Gonum a datové rámce Gota › Seskupení","s":"Seskupení Metoda GroupBy rozdělí řádky do skupin podle hodnoty zvoleného sloupce. Pro každou skupinu pak metodou Aggregation vypočteme agregované hodnoty - zde …"},{"p":"gonum_gota.html","a":"section-11","t":"Knihovna Gonum a datové rámce Gota › Seskupení","s":"Jména sloupců s agregovanými hodnotami jsou odvozena ze jména původního sloupce a typu agregace. Součty jsou vždy typu float :"},{"p":"gonum_gota.html","a":"section-12","t":"Knihovna Gonum a datové rámce Gota › Převod na matici","s":"Převod na matici Pro další výpočty vybereme metodou Select pouze číselné sloupce a převedeme je na matici. Každý řádek matice odpovídá jedné prodejně, každý slo…"},{"p":"gonum_gota.html","a":"section-13","t":"Knihovna Gonum a datové rámce Gota › Převod na matici","s":"Výsledek:"},{"p":"gonum_gota.html","a":"section-14","t":"Knihovna Gonum a datové rámce Gota › Převod na matici","s":"S maticí můžeme provádět všechny operace, které jsme si ukázali v předchozích částech. Funkce mat.Col vrátí jeden sloupec (prodej jablek ve všech prodejnách), f…"},{"p":"gonum_gota.html","a":"section-15","t":"Knihovna Gonum a datové rámce Gota › Převod na matici","s":"Výsledek:"},{"p":"gonum_gota.html","a":"section-16","t":"Knihovna Gonum a datové rámce Gota › Maticový součin","s":"Maticový součin Z počtu prodaných kusů a jednotkových cen (v korunách za kus) vypočteme tržbu každé prodejny jako součin matice a vektoru:"},{"p":"gonum_gota.html","a":"section-17","t":"Knihovna Gonum a datové rámce Gota › Maticový součin","s":"Výsledek:"},{"p":"gonum_gota.html","a":"section-18","t":"Knihovna Gonum a datové rámce Gota › Maticový součin","s":"Pokud nás zajímá i tržba za jednotlivé druhy ovoce, vynásobíme matici diagonální maticí s cenami na hlavní diagonále. Každý sloupec matice se tím vynásobí cenou…"},{"p":"gonum_gota.html","a":"section-19","t":"Knihovna Gonum a datové rámce Gota › Maticový součin","s":"Výsledek:"},{"p":"gonum_gota.html","a":"section-20","t":"Knihovna Gonum a datové rámce Gota › Převod zpět do datového rámce","s":"Převod zpět do datového rámce Vektor s tržbami přidáme do původního datového rámce jako nový sloupec metodou Mutate . Prodejny pak seřadíme od nejvyšší tržby a …"},{"p":"gonum_gota.html","a":"section-21","t":"Knihovna Gonum a datové rámce Gota › Převod zpět do datového rámce","s":"Výsledek:"},{"p":"gonum_gota.html","a":"section-22","t":"Knihovna Gonum a datové rámce Gota › Převod zpět do datového rámce","s":"Celou matici převedeme na datový rámec funkcí dataframe.LoadMatrix . Sloupce nového rámce se jmenují X0 , X1 atd., proto je přejmenujeme a přidáme sloupec se jm…"},{"p":"gonum_gota.html","a":"section-23","t":"Knihovna Gonum a datové rámce Gota › Převod zpět do datového rámce","s":"Výsledek:"},{"p":"gonum_gota.html","a":"section-24","t":"Knihovna Gonum a datové rámce Gota › finito █","s":"finito █"},{"p":"gonum_r3.html","a":"section-0","t":"Knihovna Gonum: rotace v trojrozměrném prostoru › Knihovna Gonum: rotace v trojrozměrném prostoru","s":"//go:build example"},{"p":"gonum_r3.html","a":"section-1","t":"Knihovna Gonum: rotace v trojrozměrném prostoru › Úvodní informace","s":"Knihovna Gonum: rotace v trojrozměrném prostoru Úvodní informace V části o součinu matice a vektoru jsme vektor otočili okolo osy z o 90 stupňů tak, že jsme ho …"},{"p":"gonum_r3.html","a":"section-2","t":"Knihovna Gonum: rotace v trojrozměrném prostoru › Úvodní informace","s":"Kromě balíčků fmt , math a mat použijeme balíčky r3 a quat :"},{"p":"gonum_r3.html","a":"section-3","t":"Knihovna Gonum: rotace v trojrozměrném prostoru › Úvodní informace","s":"Převod rotace na matici zajišťuje metoda Rotation.Mat , opačný převod však v balíčku r3 nenalezneme. Napíšeme si ho tedy sami. Reálná složka kvaternionu je urče…"},{"p":"gonum_r3.html","a":"section-4","t":"Knihovna Gonum: rotace v trojrozměrném prostoru › Úvodní informace","s":"Všechny další příkazy opět umístíme do funkce main :"},{"p":"gonum_r3.html","a":"section-5","t":"Knihovna Gonum: rotace v trojrozměrném prostoru › Rotace maticí","s":"Rotace maticí Nejdříve zopakujeme příklad z části o součinu matice a vektoru. Vektor 2, 3, 4 otočíme maticí m5 okolo osy z o 90 stupňů:"},{"p":"gonum_r3.html","a":"section-6","t":"Knihovna Gonum: rotace v trojrozměrném prostoru › Rotace maticí","s":"Výsledek je stejný jako minule:"},{"p":"gonum_r3.html","a":"section-7","t":"Knihovna Gonum: rotace v trojrozměrném prostoru › Vektory a rotace z balíčku r3","s":"Vektory a rotace z balíčku r3 Vektor v balíčku r3 je jednoduchá struktura se třemi prvky X , Y a Z , se kterou se pracuje jako s hodnotou - není tedy nutné alok…"},{"p":"gonum_r3.html","a":"section-8","t":"Knihovna Gonum: rotace v trojrozměrném prostoru › Vektory a rotace z balíčku r3","s":"Hodnota úhlu π/2 není v počítači reprezentována přesně, proto výsledek obsahuje malou zaokrouhlovací chybu:"},{"p":"gonum_r3.html","a":"section-9","t":"Knihovna Gonum: rotace v trojrozměrném prostoru › Vektory a rotace z balíčku r3","s":"Rotace je reprezentována jednotkovým kvaternionem. Rotaci o úhel α okolo osy u odpovídá kvaternion cos(α/2) + sin(α/2) (uₓi + uᵧj + u z k) :"},{"p":"gonum_r3.html","a":"section-10","t":"Knihovna Gonum: rotace v trojrozměrném prostoru › Vektory a rotace z balíčku r3","s":"Výsledek:"},{"p":"gonum_r3.html","a":"section-11","t":"Knihovna Gonum: rotace v trojrozměrném prostoru › Vektory a rotace z balíčku r3","s":"Metoda Mat převede rotaci na matici typu r3.Mat . Ten implementuje rozhraní mat.Matrix , takže matici můžeme vypsat funkcí mat.Formatted a porovnat s maticí m5 …"},{"p":"gonum_r3.html","a":"section-12","t":"Knihovna Gonum: rotace v trojrozměrném prostoru › Vektory a rotace z balíčku r3","s":"Výsledek:"},{"p":"gonum_r3.html","a":"section-13","t":"Knihovna Gonum: rotace v trojrozměrném prostoru › Skládání rotací","s":"Skládání rotací Druhou rotací bude otočení okolo osy x o 90 stupňů. Pokud nejdříve provedeme rotaci rz a poté rotaci rx , odpovídá výsledná rotace součinu matic…"},{"p":"gonum_r3.html","a":"section-14","t":"Knihovna Gonum: rotace v trojrozměrném prostoru › Skládání rotací","s":"Výsledek:"},{"p":"gonum_r3.html","a":"section-15","t":"Knihovna Gonum: rotace v trojrozměrném prostoru › Skládání rotací","s":"Rotace reprezentované kvaterniony se skládají stejně, tedy násobením ve stejném pořadí. Součin kvaternionů vypočte funkce quat.Mul :"},{"p":"gonum_r3.html","a":"section-16","t":"Knihovna Gonum: rotace v trojrozměrném prostoru › Skládání rotací","s":"Vektor je otočen stejně jako při použití matic:"},{"p":"gonum_r3.html","a":"section-17","t":"Knihovna Gonum: rotace v trojrozměrném prostoru › Skládání rotací","s":"Skládání rotací (na rozdíl od skládání posunů) není komutativní. Pokud rotace provedeme v opačném pořadí, dostaneme jiný výsledek:"},{"p":"gonum_r3.html","a":"section-18","t":"Knihovna Gonum: rotace v trojrozměrném prostoru › Skládání rotací","s":"Výsledek:"},{"p":"gonum_r3.html","a":"section-19","t":"Knihovna Gonum: rotace v trojrozměrném prostoru › Převod matice na kvaternion","s":"Převod matice na kvaternion Složenou matici převedeme zpět na kvaternion funkcí rotationFromMatrix , kterou jsme si napsali na začátku. Výsledek porovnáme s kva…"},{"p":"gonum_r3.html","a":"section-20","t":"Knihovna Gonum: rotace v trojrozměrném prostoru › Převod matice na kvaternion","s":"Oba kvaterniony jsou shodné. Obecně by se mohly lišit znaménkem, protože kvaterniony q a -q představují tutéž rotaci:"},{"p":"gonum_r3.html","a":"section-21","t":"Knihovna Gonum: rotace v trojrozměrném prostoru › Převod matice na kvaternion","s":"Převod tam a zpět lze ověřit i pro obecnější rotaci, například o 1 radián okolo osy 1, 2, 3 :"},{"p":"gonum_r3.html","a":"section-22","t":"Knihovna Gonum: rotace v trojrozměrném prostoru › Převod matice na kvaternion","s":"Výsledek:"},{"p":"gonum_r3.html","a":"section-23","t":"Knihovna Gonum: rotace v trojrozměrném prostoru › Hromadění zaokrouhlovacích chyb","s":"Hromadění zaokrouhlovacích chyb Každá rotace by měla zachovat délku vektoru. Prvky matice rotace však nejsou reprezentovány přesně, takže se délka vektoru při k…"},{"p":"gonum_r3.html","a":"section-24","t":"Knihovna Gonum: rotace v trojrozměrném prostoru › Hromadění zaokrouhlovacích chyb","s":"matice rotace je ortogonální, tedy MᵀM = I"},{"p":"gonum_r3.html","a":"section-25","t":"Knihovna Gonum: rotace v trojrozměrném prostoru › Hromadění zaokrouhlovacích chyb","s":"Chyby rostou lineárně s počtem kroků. Vektor se postupně zkracuje, složená matice přestává být ortogonální a kvaternion přestává být jednotkový. Po tisíci otáčk…"},{"p":"gonum_r3.html","a":"section-26","t":"Knihovna Gonum: rotace v trojrozměrném prostoru › Hromadění zaokrouhlovacích chyb","s":"Odchylku kvaternionu od jednotkové délky lze snadno odstranit jeho vydělením vlastní absolutní hodnotou. Obnovení ortogonality matice je mnohem složitější (např…"},{"p":"gonum_r3.html","a":"section-27","t":"Knihovna Gonum: rotace v trojrozměrném prostoru › Hromadění zaokrouhlovacích chyb","s":"Výsledek:"},{"p":"gonum_r3.html","a":"section-28","t":"Knihovna Gonum: rotace v trojrozměrném prostoru › finito █","s":"finito █"},{"p":"consumer_benchmarks.html","a":"section-0","t":"Consumer benchmarks › Consumer benchmarks","s":"coding: utf-8"},{"p":"consumer_benchmarks.html","a":"section-1","t":"Consumer benchmarks › Main results","s":"Consumer benchmarks Tasks measure the speed of consuming messages from Kafka broker measure speed of all steps performed during consume message operation comput…"},{"p":"consumer_benchmarks.html","a":"section-2","t":"Consumer benchmarks › Main results","s":"Average (rounded) number of messages consumed per second and per minute is:"},{"p":"consumer_benchmarks.html","a":"section-3","t":"Consumer benchmarks › Initialization part","s":"Observations one thread was used by aggregator (expected) just 40% CPU utilization by aggregator process rest (60%) spent by I/O operations - I/O (DB I/O + Kafk…"},{"p":"consumer_benchmarks.html","a":"section-4","t":"Consumer benchmarks › Initialization part","s":"let's display all graphs without the need to call .show()"},{"p":"consumer_benchmarks.html","a":"section-5","t":"Consumer benchmarks › Loading all data files with raw metrics","s":"Loading all data files with raw metrics Two CSV files were prepared. consumer durations.csv contains just whole duration and offset, nothing else: this CSV file…"},{"p":"consumer_benchmarks.html","a":"section-6","t":"Consumer benchmarks › Loading all data files with raw metrics","s":"observe first ten items taken from this file"},{"p":"consumer_benchmarks.html","a":"section-7","t":"Consumer benchmarks › Loading all data files with raw metrics","s":"Second file is named consumer steps durations.csv . It contains five values measured for each consumed message: 1. time to read message from Kafka topic 1. time…"},{"p":"consumer_benchmarks.html","a":"section-8","t":"Consumer benchmarks › Loading all data files with raw metrics","s":"first ten items taken from this file"},{"p":"consumer_benchmarks.html","a":"section-9","t":"Consumer benchmarks › Data statistic","s":"Data statistic CSV files have been consumed and transformed into DataFrames, so it is possible to gather some statistic and display charts. let's compute averag…"},{"p":"consumer_benchmarks.html","a":"section-10","t":"Consumer benchmarks › Data statistic","s":"would be nice to display some graphs as well, especially for overall duration"},{"p":"consumer_benchmarks.html","a":"section-11","t":"Consumer benchmarks › Detailed results for first 500 messages","s":"Detailed results for first 500 messages Please note that first x1000 messages are usually processed a bit faster compared to overall average! This is because ga…"},{"p":"consumer_benchmarks.html","a":"section-12","t":"Consumer benchmarks › Detailed results for first 500 messages","s":"again, plot the behaviour over time"},{"p":"consumer_benchmarks.html","a":"section-13","t":"Consumer benchmarks › Detailed results for first 500 messages","s":"we can see that DB store is the most time demanding operation let's display relative times for each processing step"},{"p":"consumer_benchmarks.html","a":"section-14","t":"Consumer benchmarks › Possible speedup - Amdahl's law","s":"Possible speedup - Amdahl's law It would be possible to perform first four steps in parallel. So let's compute if its worth it and which speedup is possible aga…"},{"p":"consumer_benchmarks.html","a":"section-15","t":"Consumer benchmarks › Possible speedup - Amdahl's law","s":"We can display stats/speedup for average, worst, and best scenarios. Average might be appropriate for the first version of this benchmark let's retrieve means f…"},{"p":"consumer_benchmarks.html","a":"section-16","t":"Consumer benchmarks › Possible speedup - Amdahl's law","s":"the first four steps can be (in theory) made parallel"},{"p":"consumer_benchmarks.html","a":"section-17","t":"Consumer benchmarks › Possible speedup - Amdahl's law","s":"last step can be parallelized just in thery - in fact I/O is the bottleneck there"},{"p":"consumer_benchmarks.html","a":"section-18","t":"Consumer benchmarks › Possible speedup - Amdahl's law","s":"compute parameters for Amdahl's law"},{"p":"consumer_benchmarks.html","a":"section-19","t":"Consumer benchmarks › Possible speedup - Amdahl's law","s":"throughput for one pod/one CPU"},{"p":"consumer_benchmarks.html","a":"section-20","t":"Consumer benchmarks › Possible speedup - Amdahl's law","s":"now compute and display possible speedup for 2..32 CPUs/pods"},{"p":"consumer_benchmarks.html","a":"section-21","t":"Consumer benchmarks › Possible speedup - Amdahl's law","s":"possible throughputs for 1..32 CPUs/pods"},{"p":"consumer_benchmarks.html","a":"section-22","t":"Consumer benchmarks › Possible speedup - Amdahl's law","s":"the best value for 32 CPUs/pods"},{"p":"consumer_benchmarks.html","a":"section-23","t":"Consumer benchmarks › Possible speedup - Amdahl's law","s":"display the graph"},{"p":"consumer_benchmarks.html","a":"section-24","t":"Consumer benchmarks › Possible speedup - Amdahl's law","s":"looks like that even with 32 pods/CPUs (that is really large number of pods) we can process at most ~143 messages per second"},{"p":"consumer_benchmarks.html","a":"section-25","t":"Consumer benchmarks › Possible speedup - Amdahl's law","s":"let's compute peak values per minute, per hour and per day"},{"p":"consumer_benchmarks.html","a":"section-26","t":"Consumer benchmarks › Loading all data files with raw metrics","s":"Real expectations i.e. How much messages we have to process per given timeframe (day, hour, minute, second)? Loading all data files with raw metrics The followi…"},{"p":"consumer_benchmarks.html","a":"section-27","t":"Consumer benchmarks › Loading all data files with raw metrics","s":"Let's check the content of such data by displaying first ten records read from CSV file"},{"p":"consumer_benchmarks.html","a":"section-28","t":"Consumer benchmarks › Total uploads of insights raw data per day","s":"Total uploads of insights raw data per day We can resample input data into one day buckets"},{"p":"consumer_benchmarks.html","a":"section-29","t":"Consumer benchmarks › Total uploads of insights raw data per day","s":"display graph with measured total uploads per day"},{"p":"consumer_benchmarks.html","a":"section-30","t":"Consumer benchmarks › Total uploads of insights raw data per hour","s":"Total uploads of insights raw data per hour The same operation can be done, but for 1 hour buckets"},{"p":"consumer_benchmarks.html","a":"section-31","t":"Consumer benchmarks › Total uploads of insights raw data per hour","s":"display graph with measured total uploads per hour"},{"p":"consumer_benchmarks.html","a":"section-32","t":"Consumer benchmarks › Total uploads of insights raw data per minute","s":"Total uploads of insights raw data per minute We can resample input data into 1 minute buckets"},{"p":"consumer_benchmarks.html","a":"section-33","t":"Consumer benchmarks › Total uploads of insights raw data per minute","s":"display graph with measured total uploads per hour"},{"p":"consumer_benchmarks.html","a":"section-34","t":"Consumer benchmarks › Total uploads of insights raw data per second","s":"Total uploads of insights raw data per second It is possible to resample input data into 1 second buckets"},{"p":"consumer_benchmarks.html","a":"section-35","t":"Consumer benchmarks › Total uploads of insights raw data per second","s":"display graph with measured total uploads per hour"},{"p":"consumer_benchmarks.html","a":"section-36","t":"Consumer benchmarks › Conclusion","s":"Conclusion Let's compare number of messages measured in production with the peak ratio (maximum number of messages that can be processed by using parallel pods)"},{"p":"consumer_benchmarks.html","a":"section-37","t":"Consumer benchmarks › Aggregator memory consumption","s":"Aggregator memory consumption We also need to look how much memory is allocated by aggregator process. This process exposes metrics (as many other applications …"},{"p":"consumer_benchmarks.html","a":"section-38","t":"Consumer benchmarks › Aggregator memory consumption","s":"Now it is possible to read file that contains memory consumption"},{"p":"consumer_benchmarks.html","a":"section-39","t":"Consumer benchmarks › Aggregator memory consumption","s":"Let's look at first 10 records just to see how values are stored"},{"p":"consumer_benchmarks.html","a":"section-40","t":"Consumer benchmarks › Aggregator memory consumption","s":"And display graph with results"},{"p":"consumer_benchmarks.html","a":"section-41","t":"Consumer benchmarks › Conclusion","s":"Conclusion Memory consumption is pretty low (8MB heap size) and - which is more important - it seems to be very stable over time. Also number of GC calls is low…"}],
+"terms": {
+"00": [379],
+"0000": [379],
+"10": [262,265,267,374,417],
+"100": [82,167,242],
+"100000": [379],
+"100x100": [8,93,171,186,190,208],
+"10³": [265],
+"12": [253],
+"143": [402],
+"16": [283,285],
+"1e": [253,283,374],
+"1³": [265],
+"20x10": [187],
+"22": [312],
+"222": [379],
+"256k": [379],
+"2800": [177],
+"2x4": [177],
+"3058a51d1615": [4,89],
+"32": [379,398,399,400,402],
+"32k": [379],
+"333": [265],
+"3600": [379],
+"3x3": [55,63,66,69,140,148,151,154,350],
+"3x4": [195],
+"40": [381],
+"40x40": [193,195],
+"500": [389],
+"5424": [379],
+"60": [381],
+"60x60": [193],
+"64": [379],
+"6820hq": [379],
+"70ghz": [379],
+"800": [379],
+"8192k": [379],
+"85": [319],
+"8mb": [419],
+"90": [56,141,350,354,362],
+"900": [379],
+"94": [379],
+"abs": [275,283,370,373,375],
+"absolutn": [289,294,375],
+"aby": [81,166,252],
+"accumulated": [372,373],
+"achievabl": [379],
+"adapter": [202],
+"add": [21,106,204],
+"addnode": [309],
+"addvec": [50,51,135,136],
+"adjacency": [308,309,316],
+"adres": [4,89],
+"adresar": [183,206],
+"advanc": [379],
+"after": [1],
+"again": [390,392],
+"aggregation": [334],
+"aggregation_sum": [334],
+"aggregationtype": [334],
+"aggregator": [379,381,415],
+"agreed": [1,86,171,200,218,240,259,281,302,325,350],
+"agregac": [335],
+"agregovan": [334,335],
+"akceptuj": [242,265,275,287,321],
+"akima": [273,275,277],
+"akimasplin": [273],
+"akimaspline": [273],
+"akimuv": [273],
+"ale": [23,30,108,115,200,202,243,259,281,312],
+"alespon": [173],
+"algebr": [1,86],
+"algorithms": [1],
+"algoritm": [1,86,302,303,306],
+"algoritmus": [318],
+"all": [1,379,382,383,385,393,404],
+"allocated": [415],
+"alokovat": [243,252,356],
+"als": [381,415,419],
+"alternativ": [179],
+"amdahl": [392,396],
+"amplitud": [281,283,285,289,290],
+"amplitude": [291],
+"amplitudes": [289,291],
+"an": [1,86,171,200,218,240,259,281,302,325,350],
+"analyz": [325,381],
+"analyzovat": [327],
+"and": [1,2,84,86,169,171,200,218,240,259,281,302,325,350,379,380,381,383,387,389,392,393,398,403,415,418,419],
+"ani": [23,72,108,157,171,288],
+"ankur_anand": [4,89],
+"any": [1,86,171,200,218,240,259,281,302,325,350],
+"apache": [1,86,171,200,218,240,259,281,302,325,350],
+"append": [313],
+"apples": [327,332,334,336,346],
+"applicable": [1,86,171,200,218,240,259,281,302,325,350],
+"applications": [415],
+"appropriat": [393],
+"arange": [398],
+"architectur": [379],
+"archiv": [236],
+"are": [1,2,381,389,415,417],
+"argument": [289],
+"arra": [84,169,225,229],
+"arrang": [334],
+"arrange": [334,344],
+"array_programming": [84,169],
+"arrays": [1,236],
+"as": [1,86,171,200,218,240,259,281,302,325,350,381,388,415],
+"at": [1,4,46,86,89,131,171,175,176,200,202,218,240,259,281,302,309,318,325,350,352,392,402,417],
+"atd": [1,70,86,155,171,325,346],
+"atribut": [322,330],
+"attribute": [305],
+"attributes": [304,305,322],
+"atvec": [47,132,291],
+"automaticall": [404],
+"automatick": [4,89,226],
+"averag": [379,380,387,389,393],
+"average": [414],
+"az": [246,262,308,325],
+"aᵀ": [252],
+"back": [370],
+"balicc": [303],
+"balicek": [3,88,201,218,219,240,241,260,273,282,303,350],
+"balick": [3,27,83,88,112,168,172,201,202,219,240,241,259,260,262,281,282,303,326,350,351,352,356],
+"band": [190,191,192],
+"banddens": [190],
+"bar": [200,204,205,407],
+"barevn": [200,203,204,205],
+"barv": [200],
+"barwidth": [205],
+"basic": [1,2],
+"basicall": [381],
+"basics": [1],
+"basis": [1,86,171,200,218,240,259,281,302,325,350],
+"be": [2,379,388,389,392,393,394,395,404,408,414,415,419],
+"becaus": [389],
+"been": [379,387],
+"beh": [82,167],
+"behavior": [381],
+"behaviour": [390],
+"below": [1,379],
+"benchmark": [393],
+"benchmarks": [379],
+"best": [379,387,389,393,400,414],
+"best_value": [414],
+"better": [2],
+"bez": [82,167,223,298],
+"bezn": [5,27,37,64,65,70,90,112,122,149,150,155],
+"bezpecnejs": [179],
+"bfgs": [240,245,249],
+"big": [8,9,10,11,13,93,94,95,96,98,186,187,189,208,209,210],
+"binarn": [218],
+"bit": [177,379,385,389],
+"black": [182],
+"blizk": [190],
+"blok": [171,173,177,179,180,187,191],
+"bod": [171,173,175,177,179,180,182,187,195,242,245,246,259,262,265,269,270,271,273,275,385],
+"bodech": [259,263,265,274],
+"bogomips": [379],
+"bool": [173,174,306,313],
+"bottleneck": [395],
+"bounds": [82,167],
+"brailledots": [177,178],
+"braillov": [171,177,179,187],
+"brno": [327],
+"broker": [379,381],
+"buckets": [406,408,410,412],
+"bud": [23,30,45,48,49,55,72,108,115,130,133,134,140,157,229,263,285,304,362],
+"budem": [3,30,88,115,172,183,201,241,245,269,326,327,372],
+"buffer": [223,229,234,236],
+"build": [0,85,170,199,217,239,258,280,301,324,349],
+"builder": [177,179,182],
+"but": [404,408],
+"by": [1,18,38,40,43,56,82,86,103,123,125,128,141,167,171,200,209,218,240,251,253,259,281,283,302,305,325,350,369,372,374,379,381,405,414,415],
+"by_day": [406,407],
+"by_day_plot": [407],
+"by_hour": [408,409],
+"by_hour_plot": [409],
+"by_minute": [410,411],
+"by_minute_plot": [411],
+"by_second": [412,413,414],
+"by_second_plot": [413],
+"bychom": [352],
+"byfruit": [342,346],
+"byl": [1,86,218,226],
+"byt": [2,18,38,40,41,56,81,87,103,123,125,126,141,166,265,374,379],
+"byte": [184],
+"bytes": [219,223,236],
+"cach": [379],
+"call": [382],
+"called": [1],
+"calls": [419],
+"can": [2,391,393,394,395,402,404,406,408,410,414,415],
+"canvas": [203,205],
+"cap": [31,116],
+"caps": [70,155],
+"captured": [404],
+"cark": [218,325],
+"cas": [2,281],
+"case": [179,352],
+"cast": [1,35,81,86,120,166,195,203,205,213,251,259,262,281,302,313,325,350,354],
+"castech": [240,259,273,289,338],
+"caus": [419],
+"cechy": [327],
+"cel": [173,325,346],
+"celk": [171,372],
+"cen": [340,342],
+"centimeter": [205],
+"cest": [2,87,183,302,311,312],
+"ch": [178,182],
+"charts": [387],
+"chcem": [242],
+"check": [385,394,405],
+"chovan": [65,69,150,154],
+"chtit": [30,115],
+"chyb": [82,167,246,253,266,268,269,270,275,283,294,330,350,357,372,374],
+"chybejic": [309,315],
+"ci": [1,77,86,162,174],
+"cil": [49,134],
+"cimz": [80,165],
+"cisel": [350],
+"ciseln": [325,336],
+"cisl": [36,41,121,126,282,289,325,352],
+"citeln": [6,17,35,91,102,120],
+"citelnejsim": [12,97],
+"clank": [4,84,89,169],
+"clojur": [84,169],
+"close": [205,231,232],
+"closer": [4,89],
+"cmplx": [282,283],
+"co": [197],
+"coding": [378],
+"coefficients": [283,287,289,291,293,296,298],
+"col": [64,149,328,338,346],
+"collector": [389],
+"colname": [332],
+"color": [200],
+"colorbar": [204],
+"colormap": [204],
+"colors": [203,204],
+"cols": [173,175,176,177,179,180,181,182],
+"column": [84,169,404],
+"columns": [318,328],
+"com": [4,84,89,169,219,326],
+"command": [379],
+"compar": [414],
+"comparando": [332],
+"comparator": [332],
+"compared": [1,389],
+"complex128": [283],
+"compliance": [1,86,171,200,218,240,259,281,302,325,350],
+"complicated": [385],
+"component": [313],
+"components": [313],
+"composed": [362,368],
+"comput": [379,387,392,396,398,403],
+"computations": [2],
+"computing": [84,169],
+"conclusion": [414,419],
+"conditions": [1,86,171,200,218,240,259,281,302,325,350],
+"configurabl": [379],
+"connectedcomponents": [313],
+"const": [205,285,327],
+"consum": [379],
+"consumabl": [379],
+"consumed": [379,380,385,387],
+"consumer": [379,381],
+"consumer_durations": [383],
+"consumer_steps_durations": [385],
+"consumers": [379],
+"consuming": [379],
+"consumption": [415,416,419],
+"contains": [1,383,385,404,416],
+"content": [405],
+"coordinat": [225],
+"coordinate": [225,227],
+"copy": [1,86,171,200,218,240,259,281,302,325,350],
+"copyright": [1,86,171,200,218,240,259,281,302,325,350],
+"cor": [379],
+"correct": [385],
+"correctl": [404],
+"cos": [267,277,358],
+"cosmos72": [84,169],
+"count": [406,408,410,412],
+"cov": [214,215],
+"covariancematrix": [214],
+"coz": [25,71,110,156,315,375],
+"cpu": [379,381,397],
+"cpus": [398,399,400,402],
+"create": [205,231],
+"crop": [205],
+"csv": [218,221,223,325,327,379,381,383,385,387,404,405,415,416],
+"cten": [44,46,129,131],
+"ctverc": [27,112,251,255,270],
+"ctvercov": [63,69,77,148,154,162],
+"ctvereck": [180],
+"ctvrt": [245,270],
+"ctvrtin": [296],
+"ctyr": [318],
+"ctyrech": [187],
+"ctyrikrat": [270],
+"ctyrm": [16,62,101,147,222],
+"ctyrmi": [225],
+"current": [2],
+"cw": [182],
+"cz": [4,84,89,169],
+"d1": [73,158],
+"d2": [74,75,159,160],
+"d3": [76,161],
+"dal": [16,101,210],
+"dals": [4,15,42,48,73,83,84,89,100,127,133,158,168,169,185,207,220,240,244,261,284,307,329,336,353],
+"dalsi": [196],
+"dan": [200,289,291],
+"dat": [1,2,4,7,9,35,83,84,86,89,92,94,120,168,169,218,231,325,327,381,383,387,404,405,406,408,410,412],
+"data": [213,214,262,265,287,321,344,373],
+"datafram": [326,330,346],
+"dataframe": [326,328,330,332,334,344,346],
+"dataframes": [84,169,387],
+"datov": [2,27,87,112,325,328,330,331,344,346],
+"day": [403,404,406,407],
+"db": [381,385,391,395],
+"dc": [205],
+"default": [179,352,415],
+"defer": [41,126,205],
+"deklarac": [4,7,89,92],
+"delil": [352],
+"delk": [31,116,287,304,311,312,372,375],
+"demanding": [391],
+"dens": [5,27,90,112,318],
+"dense": [15,23,25,100,108,110,210,315,316,328,342,362,373],
+"dense1": [60,145],
+"dense2": [61,146,251],
+"dense3": [62,147],
+"dense4": [63,64,65,66,67,68,148,149,150,151,152,153],
+"derivac": [243,277],
+"describe": [387,389,391,392,393,406,408,410,412,414],
+"deset": [35,45,120,130,187,209,268,272],
+"desetinn": [272,283],
+"desetkrat": [250],
+"det": [66,151],
+"detailed": [381,389],
+"determinant": [66,151],
+"devdocs": [84,169],
+"devet": [69,154,288],
+"df": [328,330,332,334,336,344,346],
+"diag": [75,160],
+"diagonal": [68,72,74,77,79,153,157,159,162,164,188,190,192,309,342,352],
+"diagonaln": [68,73,76,153,158,161,171,186,342],
+"diagview": [68,79,153,164],
+"difference": [294],
+"dijkstrafrom": [311],
+"dijkstrov": [302],
+"dik": [243],
+"dimenz": [32,70,117,155],
+"dims": [32,70,75,117,155,160,173,176,177,179,180,182,202,318,328],
+"dir": [183,206],
+"disk": [172],
+"diskretn": [281],
+"displa": [381,382,387,388,391,393,398,401,407,409,411,413,418],
+"displaying": [405],
+"dispozic": [72,75,77,157,160,162],
+"distributed": [1,86,171,200,218,240,259,281,302,325,350],
+"dlouh": [242],
+"dne": [242],
+"do": [2,4,23,51,87,89,108,136,171,175,180,183,184,185,203,206,207,208,214,220,223,227,231,234,242,243,244,249,252,261,265,266,267,269,281,284,285,287,289,303,304,307,309,311,312,315,318,321,325,328,329,334,344,353],
+"doc": [84,169],
+"docasn": [231],
+"docs": [183,206],
+"documentation": [84,169],
+"dod": [240],
+"does": [2,389,404,419],
+"dokonc": [174],
+"dokumentac": [171,180,183,206],
+"doln": [77,78,80,162,163,165,193,195,196,225,229,281,296],
+"dolnim": [225],
+"don": [404,408],
+"doplnek": [200],
+"doplnen": [226],
+"dopoustej": [268],
+"dosazen": [319],
+"dostanem": [242,272,298,366],
+"dostupn": [183],
+"dot": [57,142,252,303,304,305,321,323],
+"downsampling": [173],
+"draw": [201,205],
+"drobn": [283],
+"druh": [11,23,30,39,46,47,96,108,115,124,131,132,196,216,221,330,336,338,342,362],
+"dsp": [281,282],
+"dulezit": [318],
+"duration": [383,385,388],
+"duration_steps": [385,386,389,390,391,392,393],
+"durations": [379,383,384,387,388],
+"during": [379,389],
+"duvod": [2,87,375],
+"dva": [3,88,179,225,259],
+"dve": [16,21,46,101,106,131,205,216,259],
+"dvem": [38,123,190],
+"dvo": [25,50,54,57,110,135,139,142,187,203,242,313],
+"dvojic": [48,72,133,157],
+"dvojnasobk": [216],
+"dvourozmern": [46,60,131,145],
+"dx": [178],
+"dy": [178],
+"e6": [283],
+"each": [385,389,391],
+"edg": [305],
+"edges": [309,315],
+"either": [1,86,171,200,218,240,259,281,302,325,350],
+"elegantn": [8,93],
+"els": [383],
+"else": [181],
+"en": [84,169],
+"encoder": [305],
+"encoding": [303,305],
+"endian": [379],
+"equal": [232,316],
+"equalapprox": [360],
+"err": [41,126,184,205,209,212,215,221,223,225,227,229,231,232,234,236,245,249,253,255,273,321,330,346],
+"error": [203,265,267,271,372],
+"especiall": [388],
+"etc": [1,387],
+"evals": [247],
+"even": [402],
+"exact": [265,267,269,271],
+"exampl": [2],
+"example": [0,85,170,199,217,239,258,280,301,324,349],
+"examples": [219],
+"except": [1,86,171,200,218,240,259,281,302,325,350],
+"excerpt": [11,13,96,98,171],
+"existuj": [46,69,131,154],
+"existujic": [75,160],
+"expectations": [404],
+"expected": [381],
+"explicitn": [44,129],
+"export": [180,303,304,321],
+"exported_metrics": [415],
+"exportovat": [321],
+"exposes": [415],
+"express": [1,86,171,200,218,240,259,281,302,325,350],
+"ext": [205],
+"fact": [395],
+"factors": [381],
+"faktor": [319],
+"famil": [379],
+"faster": [389],
+"faz": [289],
+"fft": [281,287,291,293,296,298],
+"fig": [401],
+"figsize": [391,401,418],
+"figure": [401],
+"fil": [379,383,384,385,386,404,405,415,416],
+"file": [1,86,171,200,218,240,259,281,302,325,350],
+"filename": [203,205,231,232],
+"filepath": [172,183,201,205,206,219,231],
+"files": [379,381,383,387,404],
+"fill": [182],
+"filter": [332],
+"filtered": [298,332],
+"filtr": [281],
+"filtrac": [296,325,332],
+"finit": [83,168,198,216,238,257,279,300,323,348,377,419],
+"first": [384,386,389,392,393,394,404,405,417],
+"fit": [273],
+"fittablepredictor": [273],
+"fiv": [385,393],
+"fixed": [271],
+"float": [328,335,344],
+"float64": [7,16,30,35,42,44,48,55,56,57,61,62,63,66,69,74,76,77,78,81,92,101,115,120,127,129,133,140,141,142,146,147,148,151,154,159,161,162,163,166,181,182,195,202,208,210,213,218,234,242,243,245,251,252,253,262,267,269,273,275,285,308,318,328,340,342,354,372,373],
+"floats": [260,269,275],
+"fmt": [3,6,7,10,11,13,17,19,21,23,25,28,30,31,32,33,37,39,41,43,45,46,47,48,50,51,52,53,54,55,56,57,58,59,60,61,62,63,64,65,66,67,68,69,71,72,73,74,75,76,77,78,79,80,82,88,91,92,95,96,98,102,104,106,108,110,113,115,116,117,118,122,124,126,128,130,131,132,133,135,136,137,138,139,140,141,142,143,144,145,146,147,148,149,150,151,152,153,154,156,157,158,159,160,161,162,163,164,165,167,172,177,179,182,184,187,191,193,195,197,201,209,210,212,214,215,219,221,223,225,227,229,231,232,234,236,241,245,247,249,253,255,260,263,265,267,269,271,273,275,277,282,285,287,289,291,294,296,298,303,305,309,311,313,316,319,321,326,330,332,334,336,338,340,342,344,346,351,354,356,358,360,362,364,366,368,370,372,373,375],
+"following": [404,415],
+"font": [179],
+"for": [1,2,9,44,46,47,84,86,94,129,131,132,169,171,174,175,176,178,179,182,186,190,193,200,208,218,240,249,253,259,267,269,273,275,281,283,285,291,296,302,306,309,311,313,315,318,319,325,328,350,372,385,388,389,391,393,396,397,398,399,400,408],
+"form": [171,200,218,331],
+"format": [17,102,171,180,189,203,205,209,212,218,221,223,225,227,229,231,303,304,321,325,327],
+"formatted": [11,13,17,19,21,23,25,28,30,33,37,39,42,43,45,48,50,51,52,53,54,55,56,60,61,62,63,68,69,71,72,73,74,76,77,78,79,80,82,96,98,102,104,106,108,110,113,115,118,122,124,127,128,130,133,135,136,137,138,139,140,141,145,146,147,148,153,154,156,157,158,159,161,162,163,164,165,167,171,176,210,214,221,225,227,236,255,263,285,289,296,298,316,336,340,342,354,360,362],
+"four": [392,394],
+"fourier": [281,282,287],
+"fourierov": [281],
+"fprintf": [177,179,182],
+"frames": [1,381],
+"frekvenc": [281,288,289,291,296],
+"frekvencn": [281,296],
+"freq": [291,296],
+"frequenc": [415],
+"frequency": [291],
+"frequentl": [389],
+"from": [1,379,381,384,385,386,405],
+"func": [4,41,89,126,173,176,177,179,180,183,184,185,202,203,206,207,220,242,243,244,245,249,252,253,261,275,283,284,305,306,307,313,328,329,352,353],
+"funcevaluations": [247,249,253],
+"function": [247],
+"funkc": [4,11,23,43,57,67,77,83,89,96,108,128,142,152,162,168,171,185,203,207,220,221,225,231,236,240,242,244,245,246,247,250,251,252,259,260,261,262,263,265,267,268,269,271,272,273,274,275,277,282,284,289,307,311,313,319,321,329,330,338,346,353,360,364,368],
+"funkcionalit": [325],
+"garbag": [389],
+"gather": [387],
+"gathered": [415],
+"gauss": [271],
+"gaussov": [271],
+"gc": [419],
+"general": [227],
+"generated": [381],
+"genuineintel": [379],
+"get_ipython": [382],
+"getenv": [183,206],
+"github": [84,169,219,326],
+"given": [404],
+"go": [0,1,2,4,35,64,65,84,85,86,87,89,120,149,150,169,170,199,217,239,258,259,271,280,301,324,325,326,349,389,415],
+"go_gc_duration_seconds_count": [415],
+"go_gc_duration_seconds_sum": [415],
+"go_memstats_alloc_bytes": [415],
+"go_memstats_frees_total": [415],
+"go_memstats_mallocs_total": [415],
+"go_memstats_sys_bytes": [415],
+"godoc": [83,168],
+"going": [381],
+"golang": [4,84,89,169],
+"gomacr": [84,169],
+"gonum": [1,2,3,83,84,86,87,88,168,169,171,172,190,200,201,218,219,227,240,241,259,260,281,282,302,303,325,326,350,351],
+"gonum_sp": [206],
+"gopherdat": [84,169],
+"gophernotes": [84,169],
+"gorill": [84,169],
+"gorutin": [271],
+"got": [84,169,325,326],
+"gota": [326],
+"governing": [1,86,171,200,218,240,259,281,302,325,350],
+"grad": [243,245,249,252,253],
+"gradevaluations": [247,249,253],
+"gradient": [240,243,245,246,247,249,250,252,253],
+"gradientthreshold": [246,253],
+"graf": [1,86,171,184,200,203,302,303,304,308,309,313,315,316,318,319,321,323],
+"grafic": [375],
+"grafum": [200],
+"graph": [303,306,401,407,409,411,413,418],
+"graphs": [381,382,388],
+"graphviz": [321],
+"greater": [332],
+"grid": [174,175,177,178,179,180,182,418],
+"gridxyz": [202],
+"groupb": [334],
+"groupby": [334],
+"grouped": [334],
+"has": [379],
+"hav": [387,389,404],
+"head": [384,386,405,417],
+"header": [221],
+"heap": [419],
+"heatmap": [200,203,209,212,215],
+"heatmap_big": [209,210],
+"heatmap_cov": [215,216],
+"heatmap_mul": [212,213],
+"height": [173,174,175,178,179,182,205],
+"her": [381],
+"hessov": [240],
+"hideaxes": [204],
+"hidex": [204],
+"hlavicc": [176],
+"hlavick": [178,197,223,225],
+"hlavn": [9,68,72,74,77,79,94,153,157,159,162,164,188,190,306,309,342,352],
+"hledam": [251],
+"hledan": [240,253,302],
+"hledat": [245],
+"ho": [227,250,287,350,352],
+"hod": [250,325],
+"hodnocen": [319,320],
+"hodnot": [4,7,10,11,23,30,44,46,57,58,61,66,67,69,74,76,77,78,81,89,92,95,96,108,115,129,131,142,143,146,151,152,154,159,161,162,163,166,173,200,202,204,208,218,242,246,247,249,259,262,263,265,269,273,274,285,287,289,290,293,294,319,325,328,330,332,334,335,356,357,374,375],
+"horn": [69,77,80,82,154,162,165,167,196],
+"hour": [403,404,408,409,411,413],
+"how": [2,404,415,417],
+"however": [1],
+"hran": [302,304,308,309,311,312,315,318,322],
+"hromaden": [350,372],
+"html": [84,169,206],
+"http": [1,86,171,182,200,218,240,259,281,302,325,350],
+"https": [4,83,84,89,168,169],
+"hust": [218],
+"i7": [379],
+"id": [306,379],
+"identifikator": [306],
+"identity": [11,96],
+"ids": [306],
+"if": [41,126,173,174,175,176,178,179,181,182,183,184,190,204,205,206,209,212,215,221,223,225,227,229,231,232,234,236,245,249,253,255,273,291,296,309,318,321,330,346,372,385,392],
+"imag": [352],
+"imaginarn": [352],
+"imaginarnim": [350],
+"implement": [2],
+"implementac": [303],
+"implemented": [1],
+"implementovan": [1,86],
+"implementovat": [2,87],
+"implementuj": [227,273,360],
+"implementujic": [249,275],
+"implied": [1,86,171,200,218,240,259,281,302,325,350],
+"import": [3,88,172,201,219,241,260,282,303,326,351,381],
+"important": [419],
+"in": [1,2,84,86,169,171,200,218,240,259,281,302,325,350,379,385,387,392,394,395,414,415],
+"index": [36,41,46,47,84,121,126,131,132,169,225,291],
+"indexac": [41,126],
+"inf": [294,309,373],
+"informac": [1,4,11,70,75,83,86,89,96,155,160,168,171,200,218,240,259,281,302,325,350],
+"inicializac": [61,146],
+"inicializovan": [78,163],
+"inicializovat": [30,115],
+"initialization": [381],
+"inline": [382],
+"input": [221,404,406,410,412],
+"insights": [406,408,410,412],
+"instalac": [1,86],
+"installation": [1],
+"installed": [2],
+"int": [2,173,176,177,179,180,202,269,306,313,379,380,385,387,406,410,412,414,415],
+"int64": [236,306,311,313,319,383],
+"integrac": [2,87,259,271],
+"integral": [259,265,267],
+"integrat": [259,260,262],
+"integrate": [260,265,267,269],
+"integration": [2],
+"integruj": [259,271],
+"intel": [379],
+"interaktivn": [84,169],
+"intern": [6,27,91,112],
+"interp": [259,260,262,273,275],
+"interpolac": [259,273,274,275],
+"interpolovan": [277],
+"interval": [39,124,265,267,273],
+"introduction": [1],
+"introtogonum": [84,169],
+"io": [84,169],
+"ipython": [84,169],
+"is": [1,2,86,171,200,218,240,259,281,302,325,350,379,380,381,385,387,389,391,392,395,402,412,415,416,419],
+"it": [2,379,381,385,387,392,404,412,416,419],
+"items": [384,386],
+"iter": [249,253],
+"iterac": [247],
+"iterations": [247],
+"iterativn": [319],
+"its": [392],
+"itself": [1],
+"jablek": [332,334,338],
+"jak": [2,8,29,35,40,41,44,49,67,77,87,93,114,120,125,126,129,134,152,162,171,176,186,200,206,251,262,272,281,289,291,318,328,330,340,344,355,356,362,365],
+"jakozt": [35,120],
+"jazyc": [44,129],
+"jazyk": [1,2,4,35,64,65,69,84,86,87,89,120,149,150,154,169,325],
+"je": [1,2,4,5,7,8,11,21,23,27,33,35,39,42,44,46,47,55,57,64,65,66,68,69,71,74,77,86,87,89,90,92,93,96,106,108,112,118,120,124,127,129,131,132,140,142,149,150,151,153,154,156,159,162,171,173,174,175,180,188,192,195,200,203,211,214,216,222,223,227,229,231,240,242,245,246,249,251,252,253,265,266,267,268,270,271,273,277,288,289,291,294,302,304,306,309,312,313,315,319,325,330,336,346,350,352,355,356,358,362,365,373,375],
+"jeden": [32,72,117,157,173,177,187,195,338,375],
+"jedin": [4,23,47,89,108,132,177,231,242,271,286,320,350],
+"jedn": [1,4,27,29,35,42,77,82,86,89,112,114,120,127,162,167,171,190,200,203,213,225,240,259,285,316,336,372],
+"jednick": [193,318],
+"jedno": [173,175,336],
+"jednoduch": [281,356],
+"jednoduchost": [4,89],
+"jednodus": [1,19,86,104,296],
+"jednorozmern": [27,47,112,132,234],
+"jednotk": [340,350,375],
+"jednotkov": [186,190,208,358,374],
+"jednotliv": [70,155,281,319,342],
+"jednoznacn": [251],
+"jeh": [242,281,309,321,374,375],
+"jehoz": [42,127,315],
+"jej": [4,19,31,44,50,61,70,89,104,116,129,135,146,155,190,200,225,240,242,245,252,259,263,281,289,304,306,311,325,330],
+"jeji": [247],
+"jejichz": [250],
+"jejimz": [319],
+"jejiz": [267,325],
+"jemn": [275],
+"jen": [9,47,69,71,94,132,154,156,178,182,184,250,344],
+"ji": [47,132,171,214,232,281],
+"jin": [41,44,65,69,72,126,129,150,154,157,218,366],
+"jitted": [389],
+"jiz": [29,43,44,114,128,129,184,262,302],
+"jmag": [352],
+"jmen": [33,118,183,203,321,325,327,332,335,346],
+"jmenuj": [46,47,131,132,346],
+"join": [183,206,231],
+"jsm": [7,27,29,44,92,112,114,129,171,240,262,272,302,325,338,350,368],
+"jso": [1,27,68,73,75,77,86,112,153,158,160,162,177,179,183,203,216,225,233,234,245,249,251,271,281,289,290,303,308,315,317,318,322,327,330,331,335,369],
+"json": [385],
+"jupyter": [84,169],
+"just": [381,383,395,417],
+"k08": [4,89],
+"kafk": [379,381,385],
+"kapacit": [31,70,116,155],
+"kazd": [171,175,176,177,180,195,200,209,213,243,252,313,328,334,336,340,342,372],
+"kde": [5,90],
+"kdy": [251],
+"kdyz": [27,35,69,77,112,120,154,162,179,249],
+"ke": [200],
+"key": [305],
+"kind": [1,86,171,200,218,240,259,281,302,325,350,407],
+"kladn": [41,126],
+"klasick": [242,273],
+"kles": [270],
+"klesl": [246],
+"kmag": [352],
+"knihoven": [1,41,69,86,126,154],
+"knihovn": [1,3,8,23,44,65,69,77,84,86,88,93,108,129,150,154,162,169,171,190,200,218,219,227,231,240,259,281,302,325,326,350],
+"known": [1,379],
+"kod": [56,141,177,218],
+"koeficient": [283,287,288,289,290,291,293,296],
+"kolik": [11,96,318],
+"komplexn": [282,289,350],
+"komponent": [302,313],
+"komutativn": [366],
+"konc": [41,126,200,325],
+"koncipovan": [69,154],
+"koncovk": [203],
+"konkretni": [311],
+"konstant": [53,77,138,162],
+"konstrukc": [61,146],
+"konstruktor": [27,30,60,69,73,74,77,112,115,145,154,158,159,162,309,315,356],
+"konstruuj": [78,163],
+"kopi": [71,156],
+"korelacn": [213],
+"korelovan": [216],
+"korespondujic": [54,139,263],
+"korun": [340],
+"kosinus": [277],
+"kovariancn": [201,213,214,216],
+"kovariancni": [215],
+"krok": [374],
+"krom": [36,39,68,121,124,153,172,201,219,241,260,282,326,351],
+"kter": [1,2,5,11,27,37,48,60,68,77,81,86,87,90,96,112,122,133,145,153,162,166,171,179,195,200,203,208,218,231,240,242,251,259,262,269,271,273,283,287,291,296,302,316,318,320,321,328,331,332,338,344,350,356,368],
+"kubick": [273],
+"kus": [327,340],
+"kvadratur": [271],
+"kvaternion": [350,352,358,364,368,369,372,374,375],
+"kvazinewtonovsk": [240],
+"l1d": [379],
+"l1i": [379],
+"l2": [379],
+"l3": [379],
+"label": [305,322],
+"languag": [1,2,84,169],
+"language": [1,86,171,200,218,240,259,281,302,325,350],
+"larg": [402],
+"last": [395],
+"law": [1,86,171,200,218,240,259,281,302,325,350,392,396],
+"lbfgs": [240,249,250],
+"leastsquares": [252,253],
+"legend": [407,409,411,413],
+"legendre": [271],
+"legendrov": [271],
+"len": [31,44,46,47,116,129,131,132,178,179,182,236,252,267,283,287,306,309],
+"length": [311,372,373],
+"leps": [2,87],
+"less": [1],
+"let": [382,387,391,392,393,403,405,414,417],
+"lez": [173,242,246],
+"lezet": [202],
+"lezic": [274],
+"liberec": [327],
+"libovoln": [5,90,271,273,275,287],
+"librar": [1],
+"libraries": [1,381],
+"license": [1,86,171,200,218,240,259,281,302,325,350],
+"licensed": [1,86,171,200,218,240,259,281,302,325,350],
+"licenses": [1,86,171,200,218,240,259,281,302,325,350],
+"lichobeznik": [270,272],
+"lichobeznikov": [259,265],
+"lik": [402,404],
+"limitations": [1,86,171,200,218,240,259,281,302,325,350],
+"limiting": [381],
+"lin": [253,379],
+"linear": [1,273,275],
+"linearn": [1,86,251,259,273,274,374],
+"links": [318],
+"lis": [374],
+"lisit": [369],
+"list": [379],
+"literate": [219],
+"literate_output": [183,206],
+"littl": [379],
+"load": [231],
+"loading": [383,404],
+"loadmatrix": [346],
+"local": [379],
+"log": [379,381],
+"logick": [173],
+"lokalni": [240],
+"look": [4,89,392,415,417],
+"looks": [402],
+"low": [419],
+"lower": [78,163,179,193],
+"lze": [4,6,11,23,25,27,28,30,31,39,48,73,80,82,89,91,96,108,110,112,113,115,116,124,133,158,165,167,171,177,221,225,231,250,287,293,302,321,328,350,370,375],
+"m00": [352],
+"m01": [352],
+"m02": [352],
+"m1": [16,17,18,101,102,103,221,223,231,232,236],
+"m10": [352],
+"m11": [352],
+"m12": [352],
+"m2": [16,17,18,19,23,101,102,103,104,108,210,212,225,229,236],
+"m20": [352],
+"m21": [352],
+"m22": [352],
+"m3": [19,21,23,25,104,106,108,110,210,212,227],
+"m4": [232],
+"m5": [56,141,350,354,360],
+"ma": [11,96,287,290,320],
+"machin": [379],
+"mad": [394],
+"magnitudes": [283,289,296],
+"main": [2,4,87,89,171,185,200,207,218,220,240,244,259,261,281,284,302,307,325,329,350,353,379],
+"mainl": [1],
+"maj": [67,152,287,325],
+"majoriterations": [247,249,253],
+"make": [174,253,267,269,275,306],
+"mal": [195,268,352,357],
+"mam": [2,87],
+"man": [415],
+"manipulat": [2],
+"manipuluj": [2,87],
+"map": [200,202,203,205,209,210,213,216,236,319],
+"marshal": [321],
+"marshalbinar": [218],
+"marshall": [385],
+"marshalling": [394],
+"mat": [3,5,7,8,11,13,15,16,17,19,21,23,25,27,28,30,33,35,37,39,42,43,44,45,48,49,50,51,52,53,54,55,56,57,58,59,60,61,62,63,64,65,66,67,68,69,71,72,73,74,76,77,78,79,80,81,82,83,88,90,92,93,96,98,100,101,102,104,106,108,110,112,113,115,118,120,122,124,127,128,129,130,133,134,135,136,137,138,139,140,141,142,143,144,145,146,147,148,149,150,151,152,153,154,156,157,158,159,161,162,163,164,165,166,167,168,171,172,173,176,177,179,180,184,186,190,193,195,201,202,203,208,210,213,214,219,221,225,227,232,234,236,240,241,251,252,255,260,262,263,282,283,285,289,293,294,296,298,303,308,316,318,326,328,336,338,340,342,351,352,354,360,362,370,372,373],
+"mat2": [7,92],
+"material": [4,41,89,126],
+"math": [260,267,269,271,273,275,277,282,283,285,294,303,309,351,352,356,362,372,373],
+"mati": [218,219,227],
+"matic": [1,2,5,6,7,8,9,10,15,16,17,18,19,20,21,23,25,27,35,46,55,56,60,61,62,63,64,65,66,67,68,69,70,71,72,73,75,76,77,78,80,81,82,86,87,90,91,92,93,94,95,100,101,102,103,104,105,106,108,110,112,120,131,140,141,145,146,147,148,149,150,151,152,153,154,155,156,157,158,160,161,162,163,165,166,167,171,173,175,176,177,180,181,186,187,189,190,193,195,200,201,202,204,206,208,210,211,213,214,216,218,221,222,223,225,227,229,231,233,240,251,252,253,255,302,308,309,315,316,317,318,325,328,336,338,340,342,346,350,352,354,360,362,365,368,372,373,374,375],
+"matice": [215,225],
+"maticov": [23,25,33,108,110,118,210,213,340],
+"matio": [219,221,223,225,227,229,231,232,234,236],
+"matlab": [1,86,171,218],
+"matplotlib": [171,381,382],
+"matrices": [1,2],
+"matrix": [2,5,11,84,90,96,169,173,176,177,179,180,184,202,203,225,227,231,236,316,352,360],
+"matrixgrid": [202,203],
+"matrixmarket": [218,225,227],
+"max": [58,67,143,152,204,275,379,414],
+"maxcells": [180],
+"maxerror": [275],
+"maxheight": [177,179],
+"maxim": [269],
+"maximaln": [67,152,177,180,275,291,294],
+"maximum": [275,414],
+"maxwidth": [177,179],
+"may": [1,86,171,200,218,240,259,281,302,325,350],
+"mead": [240,249,250],
+"mean": [391,393,414],
+"mean_value": [414],
+"means": [393,394,395],
+"measur": [379],
+"measured": [379,385,407,409,411,413,414],
+"measurement": [379],
+"medium": [4,89],
+"mel": [18,38,40,43,103,123,125,128,372,374],
+"memor": [415,416,419],
+"memory": [416,417,418],
+"memory_consumption": [415,416],
+"men": [179,273],
+"meniteln": [27,112],
+"mens": [1,86,173,195,270],
+"mentioning": [2],
+"meren": [213,259],
+"meritk": [53,138],
+"messag": [379,383,385,389],
+"messages": [379,380,389,402,404,414],
+"messages_per_second": [379,380],
+"mest": [304],
+"method": [249,253],
+"methods": [249,253],
+"metod": [9,19,21,23,32,33,35,44,46,47,50,53,64,65,66,67,68,72,75,76,77,81,83,94,104,106,108,117,118,120,129,131,132,135,138,149,150,151,152,153,157,160,161,162,166,168,202,218,240,242,245,249,250,251,252,255,259,262,265,266,268,270,272,273,287,291,293,304,311,316,322,328,332,334,336,344,352,360],
+"metrics": [383,404,415],
+"mez": [15,100,200,202,246,253,259,265,274,294,302,304,350],
+"mezn": [11,13,96,98,171],
+"mhz": [379],
+"might": [393],
+"milionkrat": [372],
+"min": [58,67,143,152,204,379,408,410,414],
+"minim": [240,242,269],
+"minimalizovat": [242,251],
+"minimaln": [27,67,112,152,251],
+"minimiz": [245],
+"minimize": [245,249,253],
+"minimum": [242,245,246,250],
+"minul": [355],
+"minut": [380,403,404,410],
+"minute": [380,403,411],
+"mirn": [242],
+"mist": [2,87,272,283],
+"mit": [45,130,374],
+"mno": [242],
+"mnoh": [1,2,12,86,87,97,375],
+"mnohd": [2,87],
+"mnozin": [302],
+"mnozstv": [201,236,240,303],
+"mocnin": [270],
+"mod": [379],
+"model": [379],
+"modifikac": [42,44,52,127,129,137],
+"modifikuj": [50,135],
+"moh": [325],
+"mohl": [56,141,369],
+"mor": [385,419],
+"morava": [327],
+"moreland": [201,203],
+"most": [391,402],
+"mozn": [2,23,33,71,74,87,108,118,156,159,240],
+"moznost": [1,27,86,112],
+"moznostm": [1,86],
+"mrizk": [173,174,175,177,178,180,182,202],
+"ms": [383,387],
+"mtx": [218],
+"mu": [69,154],
+"much": [1,2,404,415],
+"mul": [23,108,210,212,342,362,364,366,372,373],
+"mulelem": [25,110],
+"mulelemvec": [54,139,263],
+"multipl": [379],
+"mulvec": [55,56,140,141,252,340,354,362,372],
+"mus": [41,81,126,166,202,204],
+"musim": [41,126,293],
+"mutabl": [27,112],
+"mutat": [344],
+"mutate": [344,346],
+"muz": [2,87],
+"muzem": [2,8,9,67,70,77,79,87,93,94,152,155,162,164,227,249,251,271,302,316,338,360],
+"mᵀm": [372,373],
+"na": [2,4,9,11,16,18,42,48,68,72,74,77,79,82,83,84,87,89,94,96,101,103,127,133,153,157,159,162,164,167,168,169,171,172,177,180,187,188,190,200,205,216,226,240,242,246,253,269,272,283,285,286,302,309,315,318,320,325,328,336,342,346,352,360,366,368],
+"nabiz": [190,325],
+"nachyln": [273],
+"nacist": [231],
+"nacitan": [218],
+"nactem": [221,232,330],
+"nacten": [227,234,325,330],
+"nad": [48,133,179,190,252,309],
+"nadeklarujem": [15,100],
+"nahor": [202],
+"nahrazuj": [265],
+"nainstalovan": [2,87],
+"najit": [4,89],
+"nalezen": [240,242,246,302],
+"nalezl": [250],
+"naleznem": [218,281,303],
+"naleznet": [83,168],
+"nam": [11,96,379],
+"namapovan": [175],
+"name": [183,184,206,249,253,328],
+"named": [385,415],
+"nameren": [259],
+"names": [328],
+"namist": [7,30,35,92,115,120,221,283],
+"naopak": [80,82,165,167,202,250],
+"napisem": [352],
+"napln": [269],
+"naplnen": [7,92],
+"naplnim": [193],
+"naplnit": [9,94],
+"napriklad": [1,2,8,69,70,86,87,93,154,155,171,218,225,283,321,370,375],
+"naprogramovan": [231],
+"naprogramovat": [171],
+"naprost": [1,86],
+"napsal": [368],
+"nas": [281,342,344],
+"nasledn": [19,36,104,121,232],
+"nasledovan": [60,145],
+"nasledovn": [30,38,43,78,115,123,128,163],
+"nasledujic": [18,27,56,103,112,141],
+"nasob": [362],
+"nasobek": [290],
+"nasoben": [25,110,364,372],
+"nastaven": [44,76,81,129,161,166,245,253],
+"nastavuj": [72,157],
+"nastroj": [183,218,231,321],
+"natazen": [53,138],
+"nativn": [231],
+"natural": [2],
+"navazuj": [240],
+"navic": [202,225,277,302],
+"navratov": [330],
+"navy": [182],
+"navzaj": [308],
+"nazev": [67,152],
+"nazv": [221],
+"nazvan": [1,19,27,35,42,44,53,66,76,86,104,112,120,127,129,138,151,161],
+"nbsp": [65,150],
+"ne": [35,120,179],
+"nealokuj": [15,100],
+"neb": [1,72,86,157,171,174,197,213,218,250,259,302],
+"nebyl": [251],
+"nebyv": [6,91],
+"necham": [48,133],
+"nectverc": [255],
+"nedozvim": [171],
+"need": [382,415],
+"needed": [379],
+"needs": [404],
+"neexistuj": [302],
+"nej": [221,240,252,315],
+"nejak": [53,138],
+"nejdels": [203],
+"nejdriv": [15,48,100,133,202,287,354,362],
+"nejdulezitejs": [320],
+"nejedn": [54,139],
+"nejkrats": [302,311,312],
+"nejmens": [58,67,143,152,250,251,255],
+"nejprv": [35,44,77,120,129,162],
+"nejs": [259,290,306,372],
+"nejvets": [58,67,143,152],
+"nejvetsi": [352],
+"nejvyhodnejs": [231],
+"nejvys": [202],
+"nejvyss": [320,344],
+"nejz": [251],
+"nejznamejs": [350],
+"nekolik": [5,90,201,208,219,259,273,308],
+"nekonecn": [312],
+"nekopiruj": [262],
+"nekter": [1,41,48,49,86,126,133,134],
+"nelder": [240,249,250],
+"neldermead": [249],
+"nelz": [44,82,129,167,178],
+"nem": [180,182],
+"nemen": [23,108],
+"nemenil": [81,166],
+"nemus": [243,265],
+"nemusel": [252],
+"nemz": [7,92,171,190],
+"nen": [2,8,10,41,72,87,93,95,126,157,171,197,288,334,350,356,357,366],
+"nenaleznem": [352],
+"nenul": [173,176,180,190,200,225,290],
+"nenulov": [171,173,309],
+"nenulovymi": [225],
+"neobsahuj": [171],
+"neocekav": [202],
+"neodkazuj": [320],
+"neodpovid": [25,110],
+"neorientovan": [302,309,315,316],
+"nepatrn": [372],
+"nepodporuj": [2,87,227],
+"nepotrebuj": [240],
+"nepotrebujem": [255],
+"nepouziv": [249],
+"neprevazuj": [5,90],
+"neprim": [42,127],
+"nepropojen": [313],
+"neprovad": [195],
+"nerozum": [218],
+"nesymetrick": [318],
+"network": [303,319],
+"neved": [312],
+"nevrac": [243,330],
+"new": [204,205,344],
+"newbanddense": [190],
+"newdens": [60,145],
+"newdense": [5,7,8,16,55,56,60,61,62,63,90,92,93,101,140,141,145,146,147,148,186,195,208,210,213,251,318,328,354,372],
+"newdiagdens": [73,158],
+"newdiagdense": [73,74,76,158,159,161,342,373],
+"newdirectedgraph": [318],
+"newedge": [318],
+"newfft": [287],
+"newformattedcanvas": [205],
+"newheatmap": [203],
+"newreader": [221,225,227,236,330],
+"newrotation": [356,362,370,372],
+"newsymdense": [69,154,308],
+"newtridens": [77,81,162,166],
+"newtridense": [77,78,81,162,163,166,193],
+"newundirectedmatrix": [315],
+"newvecdens": [27,112],
+"newvecdense": [27,30,35,42,44,48,49,55,112,115,120,127,129,133,134,140,234,251,252,262,283,285,293,298,340,354,372],
+"newweightedundirectedgraph": [309],
+"next": [315],
+"nez": [2,87,173,195,202,273,274,296,332],
+"nezajim": [281],
+"neznam": [250],
+"nezobrazujem": [200],
+"ni": [190,302],
+"nic": [171,388],
+"nich": [240,318],
+"nichz": [262],
+"nijak": [306],
+"nikd": [320],
+"nikol": [288],
+"nil": [5,7,8,16,27,30,41,48,49,55,60,64,65,73,90,92,93,101,112,115,126,133,134,140,145,149,150,158,174,184,186,190,193,205,208,209,212,214,215,221,223,225,227,229,231,232,234,236,245,249,253,255,271,273,283,285,287,293,298,321,328,330,338,346],
+"niz": [1,9,86,94,173,200,302],
+"nnz": [176,177,179,182],
+"nod": [379],
+"node": [306,309,311,318],
+"node0": [379],
+"nodes": [306,309,311],
+"non": [176],
+"none": [407,409,411,413],
+"nonzeros": [176,177,179,182],
+"norm": [294,372,373],
+"normalizovan": [290],
+"not": [1,2,86,171,200,218,240,259,281,302,325,350,389,404,419],
+"notebook": [84,169],
+"notebooks": [84,169],
+"nothing": [383],
+"nov": [15,27,35,44,100,112,120,129,287,344,346],
+"now": [2,398,416],
+"np": [381,398],
+"npy": [218,231],
+"npz": [218,236],
+"ns": [385,394,395],
+"nteract": [84,169],
+"nul": [5,68,81,90,153,166,174,197,271,283,302],
+"nulov": [46,131,246],
+"num": [350,351,379],
+"number": [176,352,358,364,366,368,370,372,379,380,402,414,419],
+"number_of_consumed_messages": [379],
+"numerical": [1,2,84,86,169],
+"numerick": [2,87,259],
+"nump": [1,2,8,84,86,87,93,169,218,231,236,381],
+"numpy": [381],
+"nutn": [35,69,77,120,154,162,173,288,356],
+"nyn": [2,8,87,93,188,200],
+"nyquistov": [291],
+"oba": [369],
+"obalim": [252],
+"obdelnik": [27,112,180,209],
+"obe": [17,102,233,255,265,268,317,350],
+"obecn": [60,145,227,369],
+"obecnejs": [370],
+"obem": [252,302],
+"objekt": [287],
+"oblast": [1,2,86,87,173,281,296],
+"obnoven": [294,375],
+"obo": [275],
+"obrazek": [180,182,184,212,323],
+"obrazk": [171,172,180,181,183,203,206],
+"obsah": [8,18,23,42,48,93,103,108,127,133,330],
+"obsahoval": [209],
+"obsahovat": [285,325],
+"obsahuj": [1,5,86,90,177,179,182,190,218,221,231,236,240,247,273,283,302,303,318,322,327,350,357],
+"obsahujic": [39,79,124,164],
+"observ": [384],
+"observations": [381],
+"obtain": [1,86,171,200,218,240,259,281,302,325,350],
+"obtizn": [242,250],
+"obvykl": [319],
+"od": [41,69,126,154,177,203,265,267,269,274,325,344,352,366,374,375],
+"odchylk": [375],
+"odchyluj": [274],
+"oddelen": [218],
+"oddelovac": [11,96],
+"odhad": [240],
+"odhadnout": [259],
+"odhaduj": [240],
+"odkaz": [84,169,200,318,320,325],
+"odkazuj": [320],
+"odkud": [183],
+"odlisn": [65,150,208],
+"odlisuj": [69,154],
+"odpovid": [23,31,108,116,173,177,181,195,200,291,336,358,362],
+"odpovidajic": [69,154,173,180,290,309],
+"odpovidajici": [225],
+"odpovidat": [55,140,204],
+"odsazen": [321],
+"odstavc": [29,114],
+"odstran": [296],
+"odstranit": [375],
+"odvod": [352],
+"odvozen": [4,89,203,330,335],
+"of": [1,2,82,86,167,171,176,200,218,240,259,281,302,305,325,350,379,380,381,385,393,402,405,406,408,410,412,414,419],
+"offers": [1],
+"offset": [383],
+"often": [1,2],
+"ohodnocen": [309],
+"ohodnocuj": [318],
+"okol": [56,141,190,350,354,358,362,370,372],
+"olomouc": [327],
+"omezen": [240],
+"on": [1,86,171,200,218,240,259,281,302,325,350,379,406,408,410,412],
+"one": [381,397,406],
+"onl": [379],
+"op": [379],
+"opacn": [315,352,366],
+"opakovan": [287,350],
+"open": [232],
+"operac": [1,2,15,23,33,48,49,50,52,55,86,87,100,108,118,133,134,135,137,140,227,252,259,338],
+"operacn": [6,91],
+"operation": [379,391,408],
+"operations": [1,2,381],
+"operator": [2,35,44,87,120,129,332],
+"opet": [44,45,52,55,65,67,75,77,129,130,137,140,150,152,160,162,185,196,207,208,220,232,261,284,307,329,353],
+"optimalizac": [255],
+"optimalizacn": [240,242],
+"optimiz": [240,241],
+"optimize": [241,245,249,252,253],
+"or": [1,86,171,200,218,240,259,281,302,325,350,379,381],
+"order": [379],
+"org": [1,3,83,84,86,88,168,169,171,172,182,200,201,218,219,240,241,259,260,281,282,302,303,325,326,350,351],
+"orientovan": [302,318],
+"orthogonality": [373],
+"ortogonalit": [375],
+"ortogonaln": [373,374],
+"os": [172,183,184,201,205,206,219,231,232],
+"osa": [202],
+"osm": [177],
+"ostatn": [1,72,86,157,218,311,320],
+"ostrava": [327],
+"osu": [356],
+"osy": [56,141,350,354,358,362,370,372],
+"otacek": [372],
+"otacen": [356],
+"otack": [372,374],
+"otevren": [39,124,221],
+"other": [415],
+"otocen": [56,141,362,365],
+"otocil": [350],
+"otocim": [354,372],
+"out": [82,167],
+"outputfile": [183,184,206,209,212,215],
+"ove": [56,141],
+"over": [390,419],
+"overall": [388,389],
+"overim": [294],
+"overit": [370],
+"overloading": [2],
+"ovoc": [327,336,338,342],
+"ovs": [1,6,10,13,25,35,69,71,86,91,95,98,110,120,154,156,171,218,221,227,229,242,250,251,255,262,293,320,325,350,374],
+"packag": [84,169],
+"package": [2,87,171,200,218,240,259,281,302,325,350],
+"packages": [1,86],
+"pad": [41,126],
+"padding": [204],
+"page": [319],
+"pagerank": [302,318,319],
+"pak": [11,96,173,182,184,273,289,323,334,344],
+"palette": [201,203],
+"pamet": [6,15,91,100,240,243,356],
+"pandas": [1,86,325,381],
+"parabol": [265],
+"parallel": [392,394,414],
+"parallel_part": [394,396,397],
+"parallelized": [395],
+"parameters": [396],
+"parametr": [7,11,23,92,96,108,177,180,221,245,271,309,315],
+"parametrech": [21,106],
+"parcialn": [243],
+"parse_dates": [404],
+"parsed": [404],
+"part": [1,381],
+"pas": [190],
+"pasov": [171,190,193],
+"path": [172,201,219,303,311],
+"patr": [15,100],
+"patrn": [171,188,200,216],
+"pavel": [1,86,171,200,218,240,259,281,302,325,350],
+"pd": [381,383,385,404,416],
+"pdf": [203],
+"peak": [403,414],
+"pears": [327,336,346],
+"per": [379,380,383,397,402,403,404,406,407,408,409,410,411,412,413],
+"per_day": [403],
+"per_hour": [403],
+"per_minute": [403],
+"per_second": [402,403,414],
+"per_second_stat": [414],
+"perform": [392],
+"performed": [379],
+"period": [285],
+"permissions": [1,86,171,200,218,240,259,281,302,325,350],
+"pet": [13,98,187],
+"pevn": [271],
+"pi": [285,356,362,372],
+"pie": [391],
+"piecewiselinear": [273],
+"pism": [171,177,179,187],
+"pixelech": [180],
+"plat": [23,44,77,108,129,162],
+"platn": [203,205],
+"pleas": [389],
+"pln": [372],
+"ploch": [195,205],
+"plos": [187],
+"plot": [84,169,200,201,204,388,390,391,401,407,409,411,413,418],
+"plotter": [201,202,203,204],
+"plotting": [1,84,169],
+"plovouc": [325],
+"plt": [381,401],
+"plums": [327,334,336,346],
+"plzen": [327],
+"png": [203,209,210,212,213],
+"po": [25,54,110,139,225,259,273,298,325,374],
+"pocatecn": [240,315],
+"pocatecni": [245],
+"pocet": [23,55,60,108,140,145,176,177,180,247,250,269,271,287,315],
+"pochopiteln": [23,28,33,72,77,108,113,118,157,162,221],
+"pocitac": [357,375],
+"pocitat": [41,126,288],
+"poct": [23,55,60,108,140,145,269,271,272,293,327,340,374],
+"pod": [190,246,331,397],
+"podbalicek": [259,271],
+"podil": [291],
+"podivam": [42,127],
+"podkapitol": [44,48,129,133],
+"podl": [225,229,271,306,318,334,352],
+"podminen": [253],
+"podobn": [13,23,39,69,98,108,124,154,171,176,193,325],
+"podotknout": [35,120],
+"podpor": [1,86,302],
+"podporovan": [1,7,15,23,48,55,86,92,100,108,133,140],
+"podporuj": [200,218],
+"podrobn": [179],
+"podruh": [372],
+"pods": [398,399,400,402,414],
+"podvzorkovan": [173,180,195],
+"pohled": [27,42,79,112,127,164,171,188,216],
+"pojmenovan": [236],
+"pokryv": [173],
+"pokud": [2,11,23,87,96,108,173,195,240,269,271,302,342,352,362,366],
+"pokus": [41,126],
+"pokusm": [8,93],
+"pol": [27,35,112,120,231,234,236],
+"polovicn": [270,285],
+"polovin": [196,269,291],
+"polynom": [266],
+"pomalejs": [297],
+"pomer": [181],
+"pomern": [268],
+"pomoc": [9,94,251,255,315,350,375],
+"pomocn": [260],
+"ponekud": [69,154],
+"popis": [27,112],
+"popisem": [48,133],
+"popr": [4,89,218],
+"popsan": [9,94],
+"porad": [23,108,334,364,366],
+"porovnam": [273,350,368],
+"porovnan": [1,86,249,275,332],
+"porovnat": [316,360],
+"porovnav": [332],
+"porter": [84,169],
+"poskytovan": [83,168],
+"posledn": [11,68,96,153,251,271],
+"poslez": [44,129],
+"possibl": [2,379,381,385,387,392,398,399,412,416],
+"post": [84,169],
+"postacuj": [7,11,92,96],
+"postupn": [240,249,269,374],
+"posun": [366],
+"pot": [202,309,362],
+"potrebn": [287],
+"potreboval": [250],
+"potrebovat": [172,201,241,326],
+"potrebujem": [218,259],
+"pouz": [23,35,77,79,108,120,162,164,190,197,200,225,229,240,259,266,288,290,294,297,308,336],
+"pouzij": [69,154,183],
+"pouzijem": [30,115,191,219,251,260,262,282,351],
+"pouzil": [7,92,272],
+"pouzit": [2,11,27,35,44,48,49,67,77,87,96,112,120,129,133,134,152,162,171,221,227,231,245,249,271,287,302,321,325,365],
+"pouziteln": [47,132],
+"pouziv": [1,5,44,46,76,86,90,129,131,161,171,319,325],
+"pouzivaj": [213,218,375],
+"pouzivan": [1,5,86,90,218],
+"pouzivat": [3,88],
+"poved": [41,126],
+"povolen": [41,126],
+"povsimnet": [39,124,202],
+"pozadovan": [173],
+"poznamenat": [2,87],
+"poznamk": [2,8,23,35,39,87,93,108,120,124],
+"prac": [1,8,27,60,77,86,93,112,145,162,172,240,260,325],
+"pracoval": [262],
+"pracovat": [27,112,350],
+"pracuj": [262,321,356],
+"praha": [327],
+"praktick": [171,240],
+"prav": [175,255],
+"pravidl": [271],
+"prax": [218],
+"praz": [338],
+"prazdn": [174,178,182],
+"precis": [404],
+"precten": [46,64,65,131,149,150],
+"pred": [11,60,96,145,283],
+"predam": [23,108,245,319,332,356],
+"predan": [21,61,106,146,243],
+"predat": [7,30,69,74,92,115,154,159,328],
+"predav": [47,77,132,162,183],
+"predchoz": [7,27,29,44,92,112,114,129,218,240,289,338],
+"predict": [273,275],
+"predictderivative": [277],
+"predictor": [275],
+"predpocit": [287],
+"predpoklad": [23,55,108,140],
+"predstavovan": [249],
+"predstavovat": [304],
+"predstavuj": [2,87,213,369],
+"predstavujic": [27,112],
+"prefix": [11,13,96,98,321],
+"prehledn": [10,95,171],
+"prehlednejs": [306],
+"prejmenujem": [346],
+"preparation": [379],
+"prepared": [383],
+"pres": [312,325],
+"presn": [202,266,272,357,372],
+"prestav": [374],
+"pretezovan": [2,87],
+"pretizen": [44,129],
+"prett": [419],
+"preurcen": [251],
+"prevazn": [27,112],
+"preved": [360],
+"prevedem": [328,336,346,368],
+"prevod": [281,302,309,315,325,336,344,350,352,368,370],
+"pri": [82,167,171,174,183,197,229,243,253,270,281,304,319,350,365,372],
+"priblizn": [250,270],
+"prices": [340],
+"pridam": [208,309,344,346],
+"prijemc": [21,23,50,52,106,108,135,137],
+"prikaz": [4,13,89,98,185,207,220,244,261,284,307,323,329,353],
+"prikazech": [48,133],
+"priklad": [2,42,87,127,183,203,251,354],
+"prikladech": [218],
+"prilis": [6,91,171,253],
+"prim": [4,6,10,89,91,95,171,183,202,218,221,227,231,252,262,312,315,328,350],
+"primc": [253],
+"print": [223,229,379,380,394,395,396,397,400,403,414],
+"printf": [11,46,47,96,131,132,214,225,234,245,249,253,255,265,267,269,271,273,275,277,285,291,298,311,319,358,360,362,364,366,368,373,375],
+"println": [6,7,10,13,17,19,21,23,25,28,30,31,32,33,37,39,41,43,45,48,50,51,52,53,54,55,56,57,58,59,60,61,62,63,64,65,66,67,68,69,71,72,73,74,75,76,77,78,79,80,82,91,92,95,98,102,104,106,108,110,113,115,116,117,118,122,124,126,128,130,133,135,136,137,138,139,140,141,142,143,144,145,146,147,148,149,150,151,152,153,154,156,157,158,159,160,161,162,163,164,165,167,184,187,191,193,195,197,209,210,212,215,221,223,225,227,229,231,232,234,236,245,247,249,253,255,263,269,273,287,289,294,296,309,313,316,321,330,332,334,336,338,340,342,344,346,354,356,360,370,372],
+"pripad": [2,10,30,35,47,50,64,65,66,67,87,95,115,120,132,135,149,150,151,152,187,352],
+"pripomenm": [211],
+"prirozen": [2,87],
+"prislusn": [67,152,296,342],
+"prisn": [253],
+"pritom": [171],
+"pro": [1,4,5,13,15,35,41,44,46,49,55,67,68,69,75,76,77,81,84,86,89,90,98,100,120,126,129,131,134,140,152,153,154,160,161,162,166,169,171,172,180,190,191,195,200,203,208,209,213,218,225,231,240,242,249,250,251,252,255,260,266,268,282,287,288,302,306,316,321,325,334,336,350,356,370,375],
+"probl": [82,167,245,249],
+"problem": [4,89,245,249,252],
+"proc": [375],
+"process": [381,389,402,404,415,419],
+"processed": [389,414],
+"processing": [391],
+"prodal": [332],
+"prodan": [327,340],
+"prodej": [338],
+"prodejen": [346],
+"prodejn": [327,332,336,338,340,344],
+"production": [414],
+"progra": [41,126],
+"program": [44,129],
+"programming": [1,2,84,169,219],
+"programming_languag": [84,169],
+"programovac": [1,4,44,69,84,86,89,129,154,169],
+"programovaci": [2,4,35,64,65,87,89,120,149,150],
+"programs": [389],
+"project": [1,2,84,169],
+"projekt": [1,2,86,87,200,201],
+"projit": [309],
+"promenn": [4,15,89,100,183,240,242],
+"propojen": [302,308],
+"propust": [281,296],
+"prostor": [350],
+"prostred": [84,169,183],
+"prot": [27,41,112,126,214,218,246,253,268,283,293,312,334,346,352,357],
+"protoz": [6,82,91,167,209,362,369],
+"provad": [57,142,296],
+"provadet": [48,133,259,338],
+"proved": [19,61,104,146],
+"provedem": [55,140,315,362,366],
+"proveden": [35,65,120,150,362],
+"provest": [4,25,82,89,110,167,173],
+"prubeh": [247,281],
+"prvek": [25,39,54,67,72,82,110,124,139,152,157,167,171,173,175,226,302],
+"prvk": [5,7,8,9,16,25,27,30,35,36,38,39,40,41,44,45,46,53,54,55,58,59,61,63,66,67,68,69,72,74,76,77,79,81,90,92,93,94,101,110,112,115,120,121,123,124,125,126,129,130,131,138,139,140,143,144,146,148,151,152,153,154,157,159,161,162,164,166,171,173,176,177,180,186,190,195,200,208,209,218,225,229,251,262,263,294,309,338,350,352,356,372,374],
+"prvkum": [309],
+"prvky": [225],
+"prvn": [11,23,39,46,82,96,108,124,131,167,171,188,196,202,216,221,263,327,362],
+"prvni": [216],
+"psql": [379],
+"purpos": [1],
+"puvodn": [39,40,42,71,124,125,127,156,251,293,294,316,374],
+"puvodni": [42,127,315,335,344],
+"pyplot": [381],
+"python": [1,2,86,87,231,325],
+"qr": [255,375],
+"quad": [259,260,271],
+"quat": [350,351,352,358,364,366,368,370,372,373,375],
+"quickstart": [84,169],
+"r3": [350,351,352,356,360,362,364,366,370,372],
+"r_": [84,169],
+"rad": [374],
+"radc": [187],
+"radek": [195,196,202,213,221,286,327,336,338],
+"radian": [370],
+"radianech": [356],
+"radk": [11,13,16,23,32,35,55,60,62,65,82,96,98,101,108,117,120,140,145,147,150,167,171,187,196,222,302,318,321,332,334],
+"radkov": [33,118],
+"radov": [325],
+"ramc": [1,86,325,328,330,344,346],
+"ramec": [325,328,330,331,346],
+"ramecek": [182],
+"range": [174,182,249,253,267,269,273,275,283,291,296,306,313,328],
+"ranks": [319],
+"rather": [381],
+"rati": [414],
+"ratio": [396],
+"raw": [383,404,406,408,410,412],
+"rawvector": [262,265,287,344,373],
+"rcparams": [401],
+"read": [385,394,405,415,416],
+"read_csv": [383,385,404,416],
+"readcsv": [221,330],
+"readmatrixmarket": [225,227],
+"readnpy": [232,234],
+"readnpz": [236],
+"real": [225,227,352,372,404],
+"realizovan": [50,56,135,141],
+"realizuj": [53,138],
+"reall": [402],
+"realn": [281,288,352],
+"reason": [2],
+"reasonabl": [2],
+"receiver": [23,50,108,135],
+"reciver": [21,106],
+"records": [405,417],
+"recover": [41,126],
+"rect": [182],
+"redirected": [379],
+"region": [327,334,344],
+"rekl": [29,114,302,325],
+"reknem": [77,162],
+"relativ": [391],
+"relativn": [8,93,183],
+"repl": [84,169],
+"repositar": [218],
+"repositor": [84,169],
+"reprezentac": [5,6,90,91,350],
+"reprezentovan": [180,200,357,358,364,372],
+"reprezentovat": [302],
+"reprezentuj": [350],
+"reprezentujic": [56,141],
+"required": [1,86,171,200,218,240,259,281,302,325,350],
+"resampl": [406,410,412],
+"resample": [406,408,410,412],
+"resen": [21,106,251,255],
+"reset": [229,234,236],
+"residuals": [252],
+"resp": [77,162,177,281],
+"rest": [381],
+"restored": [293,294],
+"result": [245,247,249,253],
+"results": [379,389,418],
+"retezc": [172,221,325],
+"retezec": [321],
+"retriev": [393],
+"return": [174,175,176,178,179,182,183,202,205,206,221,223,225,227,229,231,232,234,236,242,245,249,252,253,255,273,275,283,305,306,313,321,328,330,346,352],
+"returns": [305],
+"revenue": [340,344],
+"revenues": [346],
+"revsort": [344],
+"rez": [1,7,30,35,36,39,42,61,64,65,86,92,115,120,121,124,127,146,149,150,218,242,243,249,252,260,262,265,269,287,313,328],
+"rezidu": [251,252],
+"ridk": [171,206,208,218,227],
+"road": [304,305,309],
+"roads": [321,323],
+"robotic": [375],
+"root": [4,84,89,169],
+"rosenbrock": [242,245],
+"rosenbrockgrad": [243,245],
+"rosenbrockov": [242],
+"rost": [374],
+"rotac": [350,352,354,356,358,360,362,364,366,369,370,372,373,375],
+"rotate": [356,364,366],
+"rotation": [350,352,364,366],
+"rotationfrommatrix": [352,368,370],
+"round": [283],
+"rounded": [380],
+"roven": [265,267],
+"rovnic": [251],
+"rovnomern": [265,269],
+"row": [65,84,150,169,338],
+"row_and_column_vectors": [84,169],
+"rows": [173,175,176,177,179,180,181,182,202,318,319,328],
+"rozdel": [334],
+"rozdelen": [303],
+"rozdelim": [205],
+"rozdil": [52,137,294,352,366],
+"rozdilov": [294],
+"rozhran": [202,227,249,273,275,360],
+"rozklad": [255,375],
+"rozlisuj": [225],
+"rozmer": [176],
+"rozmerech": [8,55,93,140,171,186,208,302,350],
+"rozmisten": [265,269],
+"rozpoznam": [193],
+"rozsah": [204],
+"rozsahl": [8,93],
+"rozsiren": [218],
+"rozumn": [2,87],
+"run": [379],
+"run_line_magic": [382],
+"rune": [177,178],
+"ruzn": [259,325],
+"rx": [362,364,366],
+"rxz": [364,368],
+"rychl": [281,298],
+"rz": [356,358,360,362,364,366],
+"rzx": [366],
+"s1": [57,142],
+"s2": [57,142],
+"sad": [1,86],
+"sales": [327,330],
+"sam": [51,136,309,315,352,408],
+"samostatn": [183,203,209],
+"samotn": [1,86,200,203,240,372],
+"samozrejm": [55,140],
+"savez": [236],
+"savez_compressed": [236],
+"sb": [177,178,179,182],
+"scale": [375],
+"scalevec": [53,138,252,293,298],
+"scenario": [414],
+"scenarios": [379,393],
+"scip": [218],
+"sdil": [173,203],
+"sdilen": [252],
+"se": [1,2,5,6,8,11,15,16,19,27,29,30,33,38,40,42,44,46,47,50,51,53,54,55,57,60,61,62,66,69,72,76,77,78,80,81,82,86,87,90,91,93,96,100,101,104,112,114,115,118,123,125,127,129,131,132,135,136,138,139,140,142,145,146,147,151,154,157,161,162,163,165,166,167,171,172,173,178,183,184,190,195,197,200,202,213,222,225,240,242,243,250,251,252,255,259,262,268,269,270,272,273,274,275,281,285,286,287,296,302,304,308,313,316,319,321,325,331,332,342,346,350,352,356,362,364,369,372,374,375],
+"search": [253],
+"seb": [51,136,179,309,315],
+"second": [379,380,385,397,402,403,404,412,413],
+"seconds": [379,415],
+"sect": [21,106],
+"sedm": [308],
+"see": [1,86,171,200,218,240,259,281,302,325,350,391,417],
+"seems": [419],
+"select": [336,344],
+"selected": [379],
+"sent": [379],
+"sequenc": [293],
+"sequence": [293,298,395],
+"sequence_part": [395,396,397],
+"seradim": [306,334,344],
+"serazen": [306,322],
+"series": [326,332,344],
+"seskupen": [325,334],
+"sest": [69,154,283,285,327],
+"sestnact": [288],
+"sestnactkrat": [270],
+"set": [1,9,82,94,167,186,208,379],
+"setband": [190],
+"setcol": [328],
+"setdiag": [76,161],
+"setedge": [318],
+"setkal": [302],
+"setkam": [259],
+"setmax": [204],
+"setmin": [204],
+"setnames": [346],
+"sets": [72,157],
+"setsym": [72,157],
+"settings": [253],
+"settr": [82,167],
+"settri": [82,167,193],
+"setvec": [42,44,127,129,283,285],
+"setweightededge": [309,315],
+"shodn": [78,163,233,317,369],
+"shoduj": [255],
+"shortest": [311],
+"show": [1,2,382,401],
+"shows": [2],
+"si": [1,2,29,39,48,77,86,87,114,124,133,162,171,189,190,195,202,211,212,218,240,281,287,302,325,338,350,352,368],
+"sic": [30,115,171,218,227,320,350],
+"signal": [281,285,287,288,290,293,294,298],
+"siln": [216],
+"silnic": [304],
+"simpl": [1,303,304,415],
+"simple": [303,304,309,311,315,318],
+"simplexov": [240],
+"simpson": [265,267,269],
+"simpsonov": [259,265,266,270],
+"simpsons": [265,267,269],
+"simulac": [259],
+"sin": [267,269,271,273,275,285,358],
+"sines": [267,273],
+"sinus": [268,277],
+"sinusovk": [285,290,297,298],
+"sirc": [174,197],
+"sirk": [177,180],
+"sit": [275],
+"siz": [180,419],
+"size": [180,181],
+"skal": [200,203,204,205],
+"skalar": [57,142],
+"skalarn": [57,66,142,151],
+"sklad": [281,285,313],
+"skladaj": [364],
+"skladan": [350,362,366,375],
+"skladat": [372],
+"skoncil": [246,253],
+"skupin": [334],
+"skutecn": [35,42,57,120,127,142,246,274,275],
+"skutecnost": [27,77,112,162],
+"sledovan": [213],
+"slic": [35,120],
+"slice": [306,313],
+"slices": [1],
+"slicevec": [35,36,39,42,120,121,124,127],
+"sloupc": [11,13,23,27,46,55,60,62,64,82,96,98,108,112,131,140,145,147,149,167,171,187,216,221,222,225,251,302,318,325,327,328,330,331,332,334,335,336,344,346],
+"sloupcov": [27,29,55,112,114,140],
+"sloupec": [32,117,202,213,216,336,338,342,344,346],
+"slouz": [81,166,200,240,259],
+"slov": [27,41,112,126],
+"slowdown": [419],
+"slozen": [368,374],
+"slozit": [171],
+"slozitejs": [375],
+"slozk": [285,290,297,352],
+"small": [195,197],
+"smer": [302],
+"smeruj": [202],
+"smoothbluered": [203],
+"smycc": [44,129],
+"smysl": [42,127,255],
+"snadn": [35,120,193,223,242,296,375],
+"so": [1,2,379,387,392],
+"socket": [379],
+"software": [1,86,171,200,218,240,259,281,302,325,350],
+"solvevec": [255],
+"som": [1,387,388,415],
+"sometimes": [404],
+"sort": [303,306,313,334],
+"sortedids": [306,313],
+"soubor": [172,184,203,221,225,231],
+"souborech": [218],
+"soucasn": [2,87,372],
+"soucast": [1,86],
+"soucet": [15,21,50,51,59,67,100,106,135,136,144,152,251,311,338],
+"soucin": [23,54,55,57,108,139,140,142,210,213,340,350,354,362,364,368],
+"souct": [50,135,334,335,352],
+"souradnic": [226],
+"souradnicov": [225,227],
+"sousednim": [265],
+"sousednost": [302,308,315,316],
+"soustav": [251],
+"souvis": [302],
+"souvislost": [302,313],
+"span": [269,275],
+"spars": [227],
+"sparse": [227],
+"spatial": [350,351],
+"spatn": [253],
+"specialn": [190],
+"specif": [404],
+"specific": [1,86,171,200,218,240,259,281,302,325,350],
+"speed": [379],
+"speedup": [379,392,393,398],
+"spektrum": [281,288],
+"spent": [381],
+"splajn": [259,273,274,277],
+"splnujic": [202],
+"spocitat": [250],
+"spol": [302],
+"spolecn": [225],
+"spravn": [23,108],
+"sprint": [305],
+"spusten": [183],
+"spy": [171,184,200],
+"spy_band": [192,193],
+"spy_big": [189,190],
+"spy_tr": [195],
+"spy_tri": [194],
+"spyblocks": [179,191,195],
+"spybraille": [177,187,193,197],
+"spygrid": [173,177,179,180],
+"spysvg": [180,184],
+"sqlit": [379],
+"sqrt": [352],
+"squares": [263,265],
+"stabl": [84,169,419],
+"stac": [309],
+"stal": [306],
+"stan": [80,165],
+"standard": [1],
+"standardn": [1,3,18,48,86,88,103,133,219],
+"star": [66,151],
+"started": [379,389],
+"stat": [201,214],
+"statistic": [387,389],
+"statistical": [84,169],
+"statistik": [247],
+"stats": [393],
+"status": [245],
+"stav": [246],
+"stejn": [21,30,40,57,67,106,115,125,142,152,173,186,189,206,223,249,250,272,287,289,306,355,364,365],
+"stejnosmern": [285,290,297],
+"step": [372,391,395],
+"stepmatrix": [372],
+"stepping": [379],
+"steps": [372,379,385,389,392,393,394],
+"sto": [332],
+"stop": [352],
+"stopped": [379],
+"stor": [385,391],
+"storag": [379,385],
+"store": [327,344,346,395],
+"stored": [385,415,417],
+"stran": [181],
+"stranc": [83,168],
+"stranek": [318],
+"strank": [4,89,318,320],
+"string": [177,178,179,180,182,183,184,203,206,223,229,236,249,321,334,336,344],
+"strings": [172,177,179,182,201,205,219,221,225,227,326,330],
+"stroke": [182],
+"struct": [202,249,304],
+"structures": [1],
+"struktur": [1,5,27,86,90,112,171,200,206,245,356],
+"studijn": [4,89],
+"studium": [84,169],
+"stupn": [56,141,266,350,354,362],
+"styl": [54,139],
+"sub": [370,373],
+"subplots": [418],
+"subvec": [52,137,252,294],
+"such": [405],
+"sum": [59,67,144,152,338],
+"support": [1,2],
+"supported": [1],
+"supports": [1],
+"sve": [290],
+"svestek": [334],
+"svet": [1,86,325],
+"svg": [171,172,180,182,189,190,192,193,194,195,203,209,215,216,323],
+"svych": [23,108],
+"svym": [259],
+"switch": [179,352],
+"sym": [316],
+"symdens": [214,225,302,316],
+"symdense": [214,316],
+"symetrick": [69,70,72,154,155,157,211,214,225,229,288,302,308,309,316,352],
+"symetricka": [225],
+"symetricnost": [72,157],
+"symmetric": [225],
+"symmetricdim": [309],
+"syst": [4,89],
+"t1": [77,79,80,162,164,165,397,399],
+"t2": [78,79,80,163,164,165],
+"t3": [81,82,166,167],
+"ta": [313,330],
+"tabulk": [325,331],
+"tak": [2,8,10,35,41,44,77,87,93,95,120,126,129,162,171,259,260,328,350],
+"taken": [384,386],
+"takov": [352],
+"taktez": [31,116],
+"takz": [2,4,87,89,177,202,227,249,251,252,253,287,288,302,309,360,372],
+"takzvan": [1,86,171,200,325],
+"tam": [370],
+"tasks": [379],
+"tat": [21,72,106,157],
+"te": [81,166],
+"techt": [48,69,133,154,265],
+"ted": [30,36,44,47,55,57,67,115,121,129,132,140,142,152,178,187,197,202,206,240,243,281,290,291,302,350,352,356,364,372,373],
+"teh": [64,65,149,150],
+"temit": [1,86],
+"tempdir": [231],
+"ten": [287,360,384,386,405,415],
+"tent": [1,35,41,44,86,120,126,129,171,200,227],
+"tentokrat": [62,147,201,212,223],
+"teplotn": [200,202,203,205,209,210,213,216],
+"teprv": [202],
+"terminal": [171,179],
+"test": [242],
+"tet": [1,4,23,35,48,86,89,108,120,133,302,325,350],
+"text": [27,112,171,186,200,204,206,210,379],
+"textov": [218],
+"than": [2],
+"that": [1,2,389,391,402,404,414,415,416],
+"the": [1,2,84,86,169,171,200,218,240,259,281,302,325,350,379,382,385,390,391,393,394,395,400,401,404,405,408,414,415,419],
+"then": [379],
+"theor": [394],
+"ther": [395],
+"thes": [1],
+"this": [1,86,171,200,218,240,259,281,302,325,350,383,384,385,386,389,393,415],
+"thread": [379,381],
+"throughput": [379,397],
+"throughputs": [399],
+"tim": [342,379,385,390,391,419],
+"time": [394],
+"time_in_minutes": [379],
+"time_in_seconds": [379],
+"timefram": [404],
+"times": [391],
+"timestamp": [385,406,408,410,412],
+"timestamps": [404],
+"timt": [34,119],
+"tisic": [209,372,374],
+"tisicin": [372],
+"tisk": [10,11,95,96,171],
+"tisnik": [219],
+"tisnovsky": [1,86,171,200,218,240,259,281,302,325,350],
+"title": [182,203,204,407,409,411,413],
+"tj": [53,70,72,138,155,157],
+"tlumic": [319],
+"tlusts": [192],
+"tm": [379],
+"to": [1,2,9,27,33,41,77,86,94,112,118,126,162,171,200,202,218,240,250,259,281,294,302,311,325,350,379,381,382,385,387,388,389,392,404,412,415,416,417,419],
+"todense": [328,336],
+"toh": [2,35,87,120,318],
+"tohot": [1,27,41,69,86,112,126,154,183,203],
+"toleranc": [319],
+"tom": [2,8,11,44,87,93,96,129,243,259],
+"tomt": [2,4,35,50,64,65,66,87,89,120,135,149,150,151,218],
+"tool": [379],
+"top": [303,313],
+"topic": [379,385],
+"topo": [303,313],
+"tot": [65,69,82,150,154,167],
+"total": [406,407,408,409,410,411,412,413],
+"totiz": [69,154,200,251,255,288],
+"trace": [352],
+"tradicni": [245],
+"transformac": [281,283,287,293,298],
+"transformed": [379,387],
+"transformovan": [71,156],
+"transponovan": [19,20,104,105,223,286],
+"transponovat": [80,165],
+"transpose": [391,393,414],
+"transpozic": [15,33,100,118],
+"trapezoidal": [265,267,269],
+"trem": [16,55,62,101,140,147,222,350,356],
+"tret": [11,49,62,82,96,134,147,167,196,245],
+"treti": [7,92,266],
+"tri": [11,96,173,193,194,218,240,250,290,302],
+"triangular": [82,84,167,169],
+"triangular_matrix": [84,169],
+"trimprefix": [205],
+"trivial": [379],
+"trojrozmern": [350],
+"trojuhelnik": [77,80,81,162,165,166,225,229],
+"trojuhelnikov": [69,77,78,82,154,162,163,167,171,193,195],
+"trojuhelniku": [225],
+"tru": [173],
+"true": [175,204,221,236,418],
+"trzb": [340,342,344],
+"tsvg": [323],
+"tut": [9,94,255],
+"tutez": [369],
+"tutorial": [84,169],
+"tvor": [196],
+"tvorb": [1,86,200,302],
+"tvoren": [36,121],
+"two": [379,383],
+"ty": [73,158,218],
+"typ": [1,2,4,27,30,35,57,66,86,87,89,112,115,120,142,151,190,200,214,225,227,229,234,249,259,262,273,275,281,287,302,304,315,316,318,325,330,331,335,350,360],
+"type": [202,304],
+"typech": [83,168],
+"types": [1],
+"typick": [5,90,251,259],
+"tyt": [44,77,129,162,190],
+"u_z": [358],
+"uce": [1,86],
+"ucebni": [41,126],
+"ucel": [35,41,44,120,126,129,171],
+"udol": [242],
+"uhel": [356,358],
+"uhl": [357],
+"uhlopricc": [9,94],
+"ukazal": [171,338],
+"ukazat": [2,87],
+"ukazem": [1,86,240,281,302,325,350],
+"ukazuj": [2,87],
+"ukladaj": [172,190],
+"ukladam": [206],
+"ukladan": [218],
+"ukladat": [183,288],
+"ukonc": [319],
+"uloh": [255],
+"ulozen": [15,51,55,100,136,140,177,218,225,229,315,327,330],
+"ulozim": [189,209,212,214,231,285,289],
+"ulozit": [23,108,225,249,287],
+"umim": [271],
+"umistim": [4,89,185,207,220,244,261,284,307,329,353],
+"umoznuj": [277],
+"under": [1,86,171,200,218,240,259,281,302,325,350],
+"undirectedmatrix": [315],
+"unicod": [171,177],
+"units": [336,338,340,342],
+"unless": [1,86,171,200,218,240,259,281,302,325,350],
+"unmarshalbinar": [218],
+"updated": [379],
+"upload_timestamps": [404,405,406,408,410,412],
+"upload_timestamps_2020_04": [404],
+"uploads": [406,407,408,409,410,411,412,413],
+"upper": [77,81,162,166,179],
+"uprav": [21,106],
+"urcen": [15,39,46,55,68,77,100,124,131,140,153,162,195,350,352],
+"urcit": [35,120,259],
+"urcuj": [177,180,271,289,309,315],
+"urcujem": [221],
+"use": [1,84,86,169,171,200,218,240,259,281,302,325,350,381],
+"useck": [265],
+"used": [1,2,305,379,381],
+"useful": [2],
+"user": [84,169],
+"using": [379,414],
+"usuall": [389],
+"utf": [378],
+"utilization": [381],
+"uvad": [202],
+"uveden": [200,249,331],
+"uvnitr": [4,89],
+"uvodn": [1,86,171,195,200,218,240,251,259,262,281,302,325,350],
+"uz": [242],
+"uzavren": [39,124],
+"uzc": [302],
+"uzitecn": [2,4,87,89,200],
+"uzivatel": [240],
+"uzk": [242],
+"uzl": [302,306,308,309,311,312,313,315,318,319,322],
+"uᵧj": [358],
+"uₓ": [358],
+"v1": [3,48,50,52,57,83,88,133,135,137,142,168,172,201,219,241,260,282,303,326,351],
+"v10": [35,36,39,120,121,124],
+"v2": [30,33,48,50,51,52,53,54,57,115,118,133,135,136,137,138,139,142],
+"v3": [44,45,46,129,130,131],
+"v4": [55,140],
+"v5": [55,56,140,141,354],
+"vah": [302,304,309,311,315,322],
+"valu": [383,400],
+"value": [305],
+"values": [385,403,414,417],
+"var": [15,23,25,100,108,110,177,179,182,210,214,223,252,255,263,273,294,313,340,342,352,362,373],
+"variant": [73,158,240,281],
+"vcetn": [39,124],
+"vcopy": [39,124],
+"ve": [1,11,23,27,42,77,82,86,96,108,112,127,162,167,171,187,189,195,209,212,218,225,227,229,245,255,296,302,318,325,327,331,338,364],
+"vec": [356,362,370,372],
+"vecdens": [27,35,112,120,234],
+"vecdense": [252,255,263,283,294,340],
+"vectors": [84,169],
+"ved": [318],
+"vedl": [82,167,200],
+"vedouc": [309],
+"vektor": [27,28,29,30,31,32,33,35,38,40,41,42,44,45,46,47,48,49,50,51,52,53,54,55,56,57,59,67,112,113,114,115,116,117,118,120,123,125,126,127,129,130,131,132,133,134,135,136,137,138,139,140,141,142,144,152,234,240,243,251,252,262,263,285,286,289,293,294,340,344,350,354,356,362,365,372,374],
+"vektorov": [54,139,171,180],
+"velicin": [213,216],
+"velikost": [5,21,31,57,69,70,77,90,106,116,142,154,155,162,180,246,253,290],
+"velk": [8,93,171,208,240],
+"velm": [1,2,4,86,87,89,213,259,296],
+"vendor": [379],
+"ver": [1,2,419],
+"version": [1,2,86,171,200,218,240,259,281,302,325,350,393],
+"vertical": [204],
+"verz": [2,87],
+"vets": [27,112,173,201,205,236],
+"vetsi": [303],
+"vetsin": [70,155,218],
+"vg": [201,205],
+"vhodn": [2,87,240],
+"vic": [240,250,274,332],
+"videl": [44,129],
+"videt": [8,93],
+"vidim": [171],
+"viewbox": [182],
+"virtualization": [379],
+"visualizing": [84,169],
+"viz": [325],
+"vlastn": [31,116,218,255,304,375],
+"vlastnost": [4,70,89,155],
+"vlozen": [304],
+"vlozit": [171],
+"volan": [30,115,243,252],
+"volb": [171],
+"vprav": [362],
+"vrac": [32,68,117,153,225,242,255,291,293,311,313,316,328],
+"vracen": [306,322],
+"vrat": [338],
+"vs": [39,124],
+"vsak": [2,87,218,240,352,372],
+"vsech": [53,59,67,74,138,144,152,159,218,227,311,315,321,338],
+"vsechn": [4,39,68,69,77,89,124,153,154,162,173,179,185,207,220,225,244,250,261,284,296,307,309,320,329,338,353],
+"vslice": [36,37,121,122],
+"vstupn": [213],
+"vt": [33,118,379],
+"vubec": [240],
+"vyberem": [332,336,344],
+"vybran": [8,49,93,134],
+"vychoz": [27,112,245,253,271],
+"vydelen": [375],
+"vydelit": [293],
+"vygenerovan": [183,206],
+"vyhodnejs": [11,96,180,325],
+"vyhradn": [240],
+"vyjadren": [251,291],
+"vykreslen": [203],
+"vykreslit": [197],
+"vymen": [218,231],
+"vymenovat": [218],
+"vynasob": [342],
+"vynasoben": [53,54,55,56,138,139,140,141,263,293,372],
+"vynasobil": [350],
+"vynasobim": [342],
+"vynasobit": [23,108],
+"vynuluj": [296],
+"vynulujem": [195],
+"vypadajic": [38,123],
+"vypadat": [30,43,115,128],
+"vypis": [178,197,283,286,306,331],
+"vypisem": [176],
+"vypisuj": [176],
+"vyplnim": [9,94],
+"vypocet": [19,66,104,151,201,246,250,271,287,302,319,352],
+"vypocitat": [259,271,277],
+"vypoct": [2,87,247,250,252,272,273,294,311,325,336,364],
+"vypoctem": [263,334,340],
+"vypocten": [255,283,368],
+"vypsat": [48,133,360],
+"vysc": [31,116,174,197],
+"vysk": [177,180],
+"vysledek": [6,20,22,23,24,26,42,43,91,105,107,108,109,111,127,128,171,194,196,210,215,228,230,235,237,247,248,252,256,264,272,276,278,292,295,299,310,314,333,334,337,339,341,343,345,347,355,356,357,359,361,363,366,367,368,371,376],
+"vysledk": [12,14,15,34,35,38,40,51,55,57,64,65,66,97,99,100,119,120,123,125,136,140,142,149,150,151,192,211,213,222,224,254,259,319,325],
+"vysledn": [203,293,362],
+"vyss": [296],
+"vystup": [18,48,103,133],
+"vytiskn": [6,91],
+"vytisknem": [17,37,102,122],
+"vytisknout": [6,11,28,91,96,113],
+"vytisten": [19,104],
+"vytvar": [77,162],
+"vytvaren": [236],
+"vytvarim": [60,145],
+"vytvor": [27,112],
+"vytvorim": [16,35,36,42,44,48,55,101,120,121,127,129,133,140,190,269,287,309,323,356],
+"vytvorit": [8,33,39,71,73,93,118,124,156,158,287],
+"vyuzij": [77,162],
+"vyuzijem": [4,89],
+"vyuzit": [77,162,171,177,179,187],
+"vyvojarum": [77,162],
+"vyzaduj": [202],
+"vzajemn": [313],
+"vzd": [23,46,69,108,131,154,335],
+"vzdalenost": [269,270],
+"vznikn": [304],
+"vzorc": [259],
+"vzork": [173,262,268,269,274,285,287,293],
+"vzorkovac": [291,296],
+"vzorkovan": [285],
+"w3": [182],
+"warmup": [389],
+"warranties": [1,86,171,200,218,240,259,281,302,325,350],
+"was": [379,381],
+"way": [2],
+"we": [1,2,381,391,393,402,404,406,410,415],
+"weav": [183],
+"web": [318,319],
+"weight": [311],
+"weightededg": [304],
+"weightededge": [304,309,315],
+"weightededges": [315],
+"well": [1,388],
+"wer": [1,379,383,404],
+"when": [1,2,404],
+"which": [2,392,419],
+"white": [182],
+"whitelisting": [394],
+"whol": [383,419],
+"width": [173,174,175,178,179,182,205],
+"wik": [84,169],
+"wikipedi": [84,169],
+"will": [1,381],
+"with": [1,86,171,200,218,240,259,281,302,325,350,381,383,402,404,407,409,411,413,414,415,418],
+"without": [1,86,171,200,218,240,259,281,302,325,350,382],
+"work": [1,381,404],
+"working": [1],
+"world": [1],
+"worst": [379,387,389,393,414],
+"worst_value": [414],
+"worth": [2,392],
+"would": [388,392],
+"wrangling": [84,169],
+"writecsv": [223],
+"writefile": [184],
+"writematrixmarket": [229],
+"writenpy": [231,234],
+"writenpz": [236],
+"writerune": [178,179],
+"writespysvg": [184,189,192,194],
+"writestring": [178,179,182],
+"writeto": [205],
+"writing": [1,86,171,200,218,240,259,281,302,325,350],
+"written": [415],
+"www": [1,4,84,86,89,169,171,182,200,218,240,259,281,302,325,350],
+"x0": [245,249,346],
+"x01": [177],
+"x02": [177],
+"x04": [177],
+"x08": [177],
+"x1": [346],
+"x10": [177],
+"x1000": [389],
+"x20": [177],
+"x2800": [178],
+"x3": [225],
+"x40": [177],
+"x80": [177],
+"x86_64": [379],
+"xmlns": [182],
+"xs": [262,265,267,273],
+"x²": [242,263,265],
+"you": [1,86,171,200,218,240,259,281,302,325,350],
+"ys": [265],
+"za": [23,55,108,140,340,342],
+"zaber": [205],
+"zabyval": [27,112,240],
+"zacatk": [368],
+"zachovat": [372],
+"zachovav": [72,157],
+"zachovavaj": [70,155],
+"zachytit": [41,126],
+"zacnem": [186,208,221,308],
+"zadan": [259,263,269,271,273,311],
+"zadn": [10,15,95,100,182,250,312],
+"zajim": [342],
+"zajimaj": [344],
+"zajimav": [4,68,89,153],
+"zajimavejs": [267],
+"zajimavost": [208],
+"zajist": [81,166,304],
+"zajistuj": [64,149,281,352],
+"zaklad": [1,4,5,86,89,90,173],
+"zakladn": [1,2,15,35,70,75,86,87,100,120,155,160,171,186,259,302],
+"zakladni": [200,210],
+"zakmitum": [273],
+"zakriven": [242],
+"zaokrouhlim": [283],
+"zaokrouhlovac": [246,283,350,357,372],
+"zaokrouhlovacim": [294],
+"zaokrouhlovan": [266],
+"zapis": [223,229,252],
+"zapisem": [184,223,318],
+"zapisovan": [33,118],
+"zapisuj": [243],
+"zaporn": [41,126,174,288,352],
+"zapotreb": [35,120],
+"zapsan": [218],
+"zapsat": [77,162],
+"zarid": [240],
+"zarucen": [334],
+"zatimc": [39,124,320],
+"zavisl": [251],
+"zavislost": [269],
+"zavolan": [19,104],
+"zbytecn": [283],
+"zbytek": [205,240],
+"zda": [77,162,171,221],
+"zde": [8,27,31,93,112,116,334],
+"zdrojov": [218],
+"ze": [1,2,4,8,23,30,35,39,41,42,44,55,77,86,87,89,93,108,115,120,124,126,127,129,140,162,171,173,200,202,210,211,216,219,246,273,285,287,302,304,311,313,318,325,328,335,350],
+"zejmen": [1,86],
+"zero": [5,6,90,91],
+"zeros": [176],
+"ziskam": [262,273],
+"ziskan": [35,43,58,67,68,75,120,128,143,152,153,160],
+"ziskat": [35,70,79,120,155,164,293],
+"zjistim": [275,313],
+"zjistit": [31,116],
+"zkopirujem": [315,328],
+"zkracuj": [374],
+"zkusm": [195],
+"zmen": [53,72,138,157,191,372],
+"zmenen": [42,45,127,130,251],
+"zmenim": [44,129],
+"zmenit": [23,82,108,167],
+"zmens": [269],
+"zmensovat": [269],
+"zminen": [200],
+"znak": [171,177,179,187,191,196],
+"znam": [1,30,41,43,69,86,115,126,128,154,262],
+"znamen": [41,126,173,245,246,271,287,318],
+"znamenk": [369],
+"znateln": [268],
+"zneprehlednoval": [283],
+"znov": [252],
+"zobecnen": [350],
+"zobraz": [179,304],
+"zobrazen": [8,18,93,103,171,173,176,177,179,195,197,200,206,213,281,283],
+"zobrazim": [171,187,200,210,289],
+"zobrazit": [177,178,195],
+"zopakujem": [354],
+"zpet": [281,325,344,368,370],
+"zpetn": [281,293,298],
+"zpracovan": [68,153,281],
+"zpracovat": [41,126,218],
+"zpusob": [27,37,44,77,112,122,129,162,173,225],
+"zpusoben": [266,294],
+"zrovn": [2,87],
+"zustal": [297],
+"zvetsim": [269],
+"zvlastn": [69,154],
+"zvolen": [229,240,246,271,287,334],
+"zvysim": [253],
+"²": [242,251],
+"α": [358],
+"π": [357]
+}};
@@ -0,0 +1,113 @@
+// Search box for pages generated by cmd/weave. The index is loaded from
+// search-index.js (variable searchIndex), so the search works even for
+// pages opened from file:// URL. Words are normalized in the same way as
+// in weave/search.go: converted to lowercase, diacritical marks are
+// removed and common Czech suffixes are stripped.
+(function () {
+    "use strict";
+
+    var diacritics = {
+        "á": "a", "ä": "a", "č": "c", "ď": "d", "é": "e", "ě": "e", "í": "i",
+        "ĺ": "l", "ľ": "l", "ň": "n", "ó": "o", "ô": "o", "ö": "o", "ŕ": "r",
+        "ř": "r", "š": "s", "ť": "t", "ú": "u", "ů": "u", "ü": "u", "ý": "y",
+        "ž": "z"
+    };
+
+    var suffixes = [
+        "ovych", "ovymi", "eho", "emu", "ymi", "ach", "ich", "ami", "emi",
+        "ove", "ovi", "ych", "ym", "im", "em", "ou", "am", "ho", "mu",
+        "a", "e", "i", "o", "u", "y"
+    ];
+
+    var maxResults = 20;
+
+    function fold(text) {
+        return Array.from(text.toLowerCase(), function (c) {
+            return diacritics[c] || c;
+        }).join("");
+    }
+
+    function stem(word) {
+        for (var i = 0; i < suffixes.length; i++) {
+            var suffix = suffixes[i];
+            if (word.endsWith(suffix) && word.length - suffix.length >= 3) {
+                return word.slice(0, word.length - suffix.length);
+            }
+        }
+        return word;
+    }
+
+    // search returns entries containing all words from query, entries
+    // with exact matches are sorted first
+    function search(query) {
+        var words = fold(query).match(/[\p{L}\p{N}_]+/gu) || [];
+        var terms = Object.keys(searchIndex.terms);
+        var scores = null;
+
+        words.forEach(function (word) {
+            var stemmed = stem(word);
+            var found = {};
+            terms.forEach(function (term) {
+                var score = 0;
+                if (term === word || term === stemmed) {
+                    score = 2;
+                } else if (term.startsWith(stemmed) || term.startsWith(word)) {
+                    score = 1;
+                }
+                if (score > 0) {
+                    searchIndex.terms[term].forEach(function (id) {
+                        found[id] = Math.max(found[id] || 0, score);
+                    });
+                }
+            });
+            if (scores === null) {
+                scores = found;
+                return;
+            }
+            var both = {};
+            Object.keys(scores).forEach(function (id) {
+                if (id in found) {
+                    both[id] = scores[id] + found[id];
+                }
+            });
+            scores = both;
+        });
+
+        return Object.keys(scores || {}).sort(function (a, b) {
+            return scores[b] - scores[a] || a - b;
+        }).map(function (id) {
+            return searchIndex.entries[id];
+        });
+    }
+
+    function show(results, list) {
+        list.innerHTML = "";
+        results.slice(0, maxResults).forEach(function (entry) {
+            var item = document.createElement("li");
+            var link = document.createElement("a");
+            link.href = entry.p + "#" + entry.a;
+            link.textContent = entry.t;
+            var snippet = document.createElement("div");
+            snippet.className = "snippet";
+            snippet.textContent = entry.s;
+            item.appendChild(link);
+            item.appendChild(snippet);
+            list.appendChild(item);
+        });
+    }
+
+    document.addEventListener("DOMContentLoaded", function () {
+        var input = document.getElementById("search");
+        var list = document.getElementById("search-results");
+        if (!input || !list || typeof searchIndex === "undefined") {
+            return;
+        }
+        input.addEventListener("input", function () {
+            if (input.value.trim().length < 2) {
+                list.innerHTML = "";
+                return;
+            }
+            show(search(input.value), list);
+        });
+    });
+})();
@@ -0,0 +1,276 @@
+/*
+Copyright © 2020 Pavel Tisnovsky
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package dump records every value printed by the literate examples as a
+// JSON record, so tools can verify results numerically instead of comparing
+// human-formatted text. Programs are not expected to call this package
+// directly; the instrument command rewrites calls such as fmt.Println or
+// fmt.Printf into calls of At(...).Println or At(...).Printf.
+//
+// Recording is enabled by setting the LITERATE_DUMP environment variable to
+// the name of the output file ("-" means standard error). When the variable
+// is not set, the printing functions behave exactly as their fmt
+// counterparts.
+package dump
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+// EnvVariable contains the name of environment variable that enables
+// recording.
+const EnvVariable = "LITERATE_DUMP"
+
+// Location identifies one print statement in the literate source.
+type Location struct {
+	Section string
+	File    string
+	Line    int
+}
+
+// Record is one JSON record emitted for each printed value.
+type Record struct {
+	Section string      `json:"section"`
+	File    string      `json:"file"`
+	Line    int         `json:"line"`
+	Index   int         `json:"index"`
+	Expr    string      `json:"expr,omitempty"`
+	Type    string      `json:"type"`
+	Rows    int         `json:"rows,omitempty"`
+	Cols    int         `json:"cols,omitempty"`
+	Data    [][]Number  `json:"data,omitempty"`
+	Value   interface{} `json:"value,omitempty"`
+	Text    string      `json:"text"`
+}
+
+// Number is float64 that can be stored in JSON even if it is not finite.
+// Infinities and NaN are encoded as strings "+Inf", "-Inf" and "NaN".
+type Number float64
+
+// MarshalJSON implements json.Marshaler interface.
+func (n Number) MarshalJSON() ([]byte, error) {
+	f := float64(n)
+	if math.IsInf(f, 0) || math.IsNaN(f) {
+		return json.Marshal(strconv.FormatFloat(f, 'g', -1, 64))
+	}
+	return json.Marshal(f)
+}
+
+// UnmarshalJSON implements json.Unmarshaler interface.
+func (n *Number) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err == nil {
+		f, err := strconv.ParseFloat(s, 64)
+		*n = Number(f)
+		return err
+	}
+	var f float64
+	err := json.Unmarshal(data, &f)
+	*n = Number(f)
+	return err
+}
+
+// Printer prints values at given location and records them.
+type Printer struct {
+	location Location
+	exprs    []string
+}
+
+var (
+	mutex   sync.Mutex
+	encoder *json.Encoder
+	opened  bool
+)
+
+// output returns JSON encoder for the file specified by LITERATE_DUMP or
+// nil when recording is disabled.
+func output() *json.Encoder {
+	if opened {
+		return encoder
+	}
+	opened = true
+
+	filename := os.Getenv(EnvVariable)
+	var w io.Writer
+	switch filename {
+	case "":
+		return nil
+	case "-":
+		w = os.Stderr
+	default:
+		f, err := os.Create(filename)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, "dump:", err)
+			return nil
+		}
+		// the file is intentionally kept open until the program exits
+		w = f
+	}
+	encoder = json.NewEncoder(w)
+	return encoder
+}
+
+// At returns printer for given location. Exprs contain source code of the
+// printed expressions (without the writer and format string).
+func At(location Location, exprs ...string) Printer {
+	return Printer{location, exprs}
+}
+
+// Print is a recording counterpart to fmt.Print.
+func (p Printer) Print(args ...interface{}) (int, error) {
+	text := fmt.Sprint(args...)
+	p.record(text, args)
+	return os.Stdout.WriteString(text)
+}
+
+// Println is a recording counterpart to fmt.Println.
+func (p Printer) Println(args ...interface{}) (int, error) {
+	text := fmt.Sprintln(args...)
+	p.record(text, args)
+	return os.Stdout.WriteString(text)
+}
+
+// Printf is a recording counterpart to fmt.Printf.
+func (p Printer) Printf(format string, args ...interface{}) (int, error) {
+	text := fmt.Sprintf(format, args...)
+	p.record(text, args)
+	return os.Stdout.WriteString(text)
+}
+
+// Fprint is a recording counterpart to fmt.Fprint.
+func (p Printer) Fprint(w io.Writer, args ...interface{}) (int, error) {
+	text := fmt.Sprint(args...)
+	p.record(text, args)
+	return io.WriteString(w, text)
+}
+
+// Fprintln is a recording counterpart to fmt.Fprintln.
+func (p Printer) Fprintln(w io.Writer, args ...interface{}) (int, error) {
+	text := fmt.Sprintln(args...)
+	p.record(text, args)
+	return io.WriteString(w, text)
+}
+
+// Fprintf is a recording counterpart to fmt.Fprintf.
+func (p Printer) Fprintf(w io.Writer, format string, args ...interface{}) (int, error) {
+	text := fmt.Sprintf(format, args...)
+	p.record(text, args)
+	return io.WriteString(w, text)
+}
+
+func (p Printer) record(text string, args []interface{}) {
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	enc := output()
+	if enc == nil {
+		return
+	}
+	// statement without arguments (e.g. empty line) is recorded too, so
+	// text printed by each statement can be reconstructed from records
+	if len(args) == 0 {
+		r := Record{Section: p.location.Section, File: p.location.File, Line: p.location.Line, Text: text}
+		if err := enc.Encode(r); err != nil {
+			fmt.Fprintln(os.Stderr, "dump:", err)
+		}
+		return
+	}
+	for i, arg := range args {
+		r := Describe(arg)
+		r.Section = p.location.Section
+		r.File = p.location.File
+		r.Line = p.location.Line
+		r.Index = i
+		r.Expr = p.expr(i, len(args))
+		r.Text = text
+		if err := enc.Encode(r); err != nil {
+			fmt.Fprintln(os.Stderr, "dump:", err)
+		}
+	}
+}
+
+// expr returns source code for i-th argument. Single multi-valued call
+// such as v.Dims() produces more values than expressions.
+func (p Printer) expr(i int, values int) string {
+	switch {
+	case len(p.exprs) == values:
+		return p.exprs[i]
+	case len(p.exprs) == 1:
+		return fmt.Sprintf("%s[%d]", p.exprs[0], i)
+	default:
+		return ""
+	}
+}
+
+// formatted wraps value returned by mat.Formatted together with the
+// original matrix, so the raw data can be recorded.
+type formatted struct {
+	fmt.Formatter
+	m mat.Matrix
+}
+
+// Formatted is a drop-in replacement for mat.Formatted that keeps the
+// formatted matrix accessible for recording.
+func Formatted(m mat.Matrix, options ...mat.FormatOption) fmt.Formatter {
+	return formatted{mat.Formatted(m, options...), m}
+}
+
+// Describe fills type, dimensions and data of given value. Location fields
+// are left empty.
+func Describe(v interface{}) Record {
+	if f, ok := v.(formatted); ok {
+		v = f.m
+	}
+
+	switch x := v.(type) {
+	case mat.Matrix:
+		rows, cols := x.Dims()
+		data := make([][]Number, rows)
+		for r := range data {
+			data[r] = make([]Number, cols)
+			for c := range data[r] {
+				data[r][c] = Number(x.At(r, c))
+			}
+		}
+		return Record{Type: typeName(x), Rows: rows, Cols: cols, Data: data}
+	case float64:
+		return Record{Type: typeName(x), Value: Number(x)}
+	case float32, int, int64, int32, uint, bool, string:
+		return Record{Type: typeName(x), Value: x}
+	default:
+		return Record{Type: typeName(x), Value: fmt.Sprint(x)}
+	}
+}
+
+// typeName returns short type name without package and pointer, i.e.
+// "Dense" for *mat.Dense.
+func typeName(v interface{}) string {
+	name := fmt.Sprintf("%T", v)
+	name = strings.TrimLeft(name, "*")
+	if i := strings.LastIndex(name, "."); i >= 0 {
+		name = name[i+1:]
+	}
+	return name
+}
@@ -1,3 +1,5 @@
+//go:build example
+
 // # Knihovna Gonum
 
 // ## Úvodní informace o knihovně Gonum
@@ -15,6 +17,22 @@
 // takzvanými "datovými rámci" (ve světě Pythonu se pro tento účeů používá
 // **pandas**) atd.
 
+//[en] # Gonum library
+//[en]
+//[en] ## Introduction to the Gonum library
+//[en]
+//[en] The Go programming language itself supports arrays and slices (after
+//[en] all, they are basic data types of this language). Work with these data
+//[en] structures is supported by the standard library as well. However, when
+//[en] compared with the well known and very often used **NumPy** library from
+//[en] the Python world (or with Matlab or R), the standard Go installation
+//[en] offers much less in this area. Some operations known from **NumPy**
+//[en] were implemented in a set of libraries that are part of project called
+//[en] simply **Gonum Numerical Packages**. This project contains mainly a
+//[en] library for working with matrices (we will show the very basics below),
+//[en] linear algebra algorithms, support for plotting, support for so called
+//[en] "data frames" (**pandas** is used for this purpose in Python) etc.
+
 /*
 Copyright © 2020 Pavel Tisnovsky
 
@@ -43,6 +61,17 @@ limitations under the License.
 // manipuluje s maticemi, které v oblasti numerických výpočtů mnohdy
 // představují základní datový typ.
 
+//[en] > Note: it is worth mentioning that integration of **NumPy** into
+//[en] **Python** is much better than in the case of **Gonum** and the **Go**
+//[en] programming language. The reason is that the current version of Go does
+//[en] not support operator overloading, so it is not possible, for example, to
+//[en] implement matrix operations in a "natural" way (**NumPy** shows that
+//[en] operator overloading, when used reasonably, can be very useful).
+//[en]
+//[en] Now, when the **Gonum** project is installed, we can show how to
+//[en] manipulate matrices, which are often the basic data type in the area of
+//[en] numerical computations.
+
 package main
 
 // Používat budeme dva balíčky - standardní balíček **fmt** a balíček **mat** z
@@ -98,7 +127,7 @@ func main() {
 	// Přímý tisk hodnoty takové matice ovšem není v žádném případě
 	// přehledný:
 	fmt.Println(big)
-	//     {{100 100 [1 0 0 0 0 0 0 0 0 0 0 0 0 0 0
+	//     &{{100 100 [1 0 0 0 0 0 0 0 0 0 0 0 0 0 0
 	//     ...
 	//     ...
 	//     ...
@@ -292,7 +321,7 @@ func main() {
 	fmt.Println(v.Dims())
 
 	// Pochopitelně je možné vytvořit i řádkový vektor o to maticovou operací transpozice zapisovanou metodou se jménem `T`
-	vt := v.T()
+	vt := v2.T()
 	fmt.Println(mat.Formatted(vt))
 
 	// S tímto výsledkem
@@ -330,7 +359,7 @@ func main() {
 	// zatímco druhý prvek "kromě" (uzavřený vs. otevřený interval).
 
 	// Podobně lze vytvořit řez obsahující všechny původní prvky
-	vcopy := v.SliceVec(0, 9)
+	vcopy := v10.SliceVec(0, 9)
 	fmt.Println(mat.Formatted(vcopy))
 
 	// Výsledkem by měl být vektor se stejnými prvky jako vektor původní
@@ -429,15 +458,15 @@ func main() {
 	for i := 0; i < w.Len(); i++ {
 		fmt.Printf("%10.6f\n", w.AtVec(i))
 	}
-	//     ⎡  1⎤
-	//     ⎢  2⎥
-	//     ⎢  3⎥
-	//     ⎢  4⎥
-	//     ⎢  5⎥
-	//     ⎢100⎥
-	//     ⎢  7⎥
-	//     ⎢  8⎥
-	//     ⎣  9⎦
+	//       1.000000
+	//       2.000000
+	//       3.000000
+	//       4.000000
+	//       5.000000
+	//     100.000000
+	//       7.000000
+	//       8.000000
+	//       9.000000
 
 	// ## Další podporované operace nad vektory
 
@@ -725,12 +754,11 @@ func main() {
 
 	// A opět jsou k dispozici metody pro získání základních informací o
 	// existující matici
-	d2.Diag()
-	//     10      // int
+	fmt.Println(d2.Diag())
+	//     10
 
-	d2.Dims()
-	//     10      // int
-	//     10      // int
+	fmt.Println(d2.Dims())
+	//     10 10
 
 	// Pro nastavení hodnoty prvku diagonální matice se používá metoda
 	// nazvaná `SetDiag`
@@ -805,8 +833,10 @@ func main() {
 	t3 := mat.NewTriDense(3, mat.Upper, []float64{1, 2, 3, 4, 5, 6, 7, 8, 9})
 	// toto provést nelze nelze: t3.SetTri(2, 0, 100)
 	// vedlo by k chybě při běhu:
-
-	//     mat: triangular set out of bounds
+	//
+	// ```
+	// mat: triangular set out of bounds
+	// ```
 
 	// Prvek ve třetím sloupci a na prvním řádku naopak změnit bez problémů
 	// lze, protože se jedná o horní trojúhelníkovou matici
@@ -0,0 +1,177 @@
+//go:build example
+
+// # Knihovna Gonum: diskrétní Fourierova transformace
+
+// ## Úvodní informace
+
+// Při zpracování signálů nás často nezajímá průběh signálu v čase, ale
+// jeho frekvenční spektrum - tedy to, z jakých frekvencí se signál skládá
+// a jaké jsou jejich amplitudy. Převod signálu do frekvenční oblasti
+// (a zpět) zajišťuje diskrétní Fourierova transformace, resp. její rychlá
+// varianta FFT. V knihovně **Gonum** ji nalezneme v balíčku
+// **dsp/fourier**. Ukážeme si transformaci reálného signálu, zobrazení
+// amplitud jednotlivých frekvencí, zpětnou transformaci a jednoduchý
+// filtr typu dolní propust.
+
+/*
+Copyright © 2020 Pavel Tisnovsky
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+// Kromě balíčků **fmt**, **math** a **mat** použijeme balíček **fourier**
+// a balíček **math/cmplx** s funkcemi pro komplexní čísla:
+
+import (
+	"fmt"
+	"math"
+	"math/cmplx"
+
+	"gonum.org/v1/gonum/dsp/fourier"
+	"gonum.org/v1/gonum/mat"
+)
+
+// Koeficienty vypočtené transformací obsahují drobné zaokrouhlovací chyby
+// (například `1e-16` namísto nuly), které by zbytečně znepřehledňovaly
+// výpis. Amplitudy proto před zobrazením zaokrouhlíme na šest
+// desetinných míst:
+func magnitudes(coefficients []complex128) *mat.VecDense {
+	v := mat.NewVecDense(len(coefficients), nil)
+	for i, c := range coefficients {
+		v.SetVec(i, math.Round(cmplx.Abs(c)*1e6)/1e6)
+	}
+	return v
+}
+
+// Všechny další příkazy opět umístíme do funkce **main**:
+func main() {
+	// ## Vzorkovaný signál
+
+	// Signál bude obsahovat 16 vzorků. Skládá se ze stejnosměrné složky
+	// s hodnotou 1, sinusovky s jednou periodou na 16 vzorků a sinusovky
+	// s poloviční amplitudou a šesti periodami na 16 vzorků. Vzorky
+	// uložíme do vektoru:
+	const n = 16
+	signal := mat.NewVecDense(n, nil)
+	for i := 0; i < n; i++ {
+		t := float64(i) / n
+		signal.SetVec(i, 1+math.Sin(2*math.Pi*t)+0.5*math.Sin(2*math.Pi*6*t))
+	}
+	fmt.Printf("%.3f\n", mat.Formatted(signal.T()))
+
+	// Transponovaný vektor se vypíše na jediný řádek:
+
+	//     [ 1.000   1.736   1.207   2.277   2.000   1.570   2.207   1.029   1.000   0.971  -0.207   0.430   0.000  -0.277   0.793   0.264]
+
+	// ## Výpočet koeficientů
+
+	// Nejdříve vytvoříme objekt typu `FFT` pro zvolený počet vzorků. Ten si
+	// předpočítá hodnoty potřebné pro transformaci, takže ho lze použít
+	// opakovaně pro libovolný počet signálů se stejnou délkou. Metoda
+	// `Coefficients` akceptuje řez, do kterého se mají koeficienty uložit
+	// (`nil` znamená, že se má vytvořit nový), a řez se vzorky signálu:
+	fft := fourier.NewFFT(n)
+	coefficients := fft.Coefficients(nil, signal.RawVector().Data)
+	fmt.Println(len(coefficients))
+
+	// Koeficientů je pouze devět, nikoli šestnáct. Spektrum reálného
+	// signálu je totiž symetrické, takže koeficienty pro záporné
+	// frekvence není nutné počítat ani ukládat:
+
+	//     9
+
+	// Koeficienty jsou komplexní čísla. Jejich absolutní hodnota určuje
+	// amplitudu dané frekvence, argument pak její fázi. Amplitudy uložíme
+	// do vektoru a zobrazíme je stejně jako v předchozích částech funkcí
+	// `mat.Formatted`:
+	amplitudes := magnitudes(coefficients)
+	fmt.Println(mat.Formatted(amplitudes))
+
+	// Nenulové jsou pouze tři koeficienty odpovídající složkám signálu.
+	// Hodnoty nejsou normalizovány, stejnosměrná složka má tedy velikost
+	// `n` a sinusovky `n/2` násobek své amplitudy:
+
+	//     ⎡16⎤
+	//     ⎢ 8⎥
+	//     ⎢ 0⎥
+	//     ⎢ 0⎥
+	//     ⎢ 0⎥
+	//     ⎢ 0⎥
+	//     ⎢ 4⎥
+	//     ⎢ 0⎥
+	//     ⎣ 0⎦
+
+	// Frekvenci, která odpovídá koeficientu s daným indexem, vrací metoda
+	// `Freq`. Je vyjádřena jako podíl vzorkovací frekvence, maximální
+	// frekvencí je tedy polovina vzorkovací frekvence (Nyquistova
+	// frekvence):
+	for i := range coefficients {
+		if amplitudes.AtVec(i) > 0 {
+			fmt.Printf("%d: frequency %.4f, amplitude %g\n", i, fft.Freq(i), amplitudes.AtVec(i))
+		}
+	}
+
+	// Výsledek:
+
+	//     0: frequency 0.0000, amplitude 16
+	//     1: frequency 0.0625, amplitude 8
+	//     6: frequency 0.3750, amplitude 4
+
+	// ## Zpětná transformace
+
+	// Z koeficientů lze zpětnou transformací získat původní signál. Metoda
+	// `Sequence` ovšem vrací hodnoty vynásobené počtem vzorků, výsledný
+	// vektor proto musíme vydělit hodnotou `n`:
+	restored := mat.NewVecDense(n, fft.Sequence(nil, coefficients))
+	restored.ScaleVec(1.0/n, restored)
+
+	// Rozdíl mezi původním a obnoveným signálem je způsoben pouze
+	// zaokrouhlovacími chybami. Ověříme to výpočtem maximální absolutní
+	// hodnoty prvků rozdílového vektoru:
+	var difference mat.VecDense
+	difference.SubVec(restored, signal)
+	fmt.Println(mat.Norm(&difference, math.Inf(1)) < 1e-12)
+
+	// Výsledek:
+
+	//     true
+
+	// ## Dolní propust
+
+	// Ve frekvenční oblasti se velmi snadno provádí filtrace. Dolní propust,
+	// která odstraní všechny frekvence vyšší než čtvrtina vzorkovací
+	// frekvence, jednoduše vynuluje příslušné koeficienty:
+	for i := range coefficients {
+		if fft.Freq(i) > 0.25 {
+			coefficients[i] = 0
+		}
+	}
+	fmt.Println(mat.Formatted(magnitudes(coefficients).T()))
+
+	// Zůstala pouze stejnosměrná složka a pomalejší sinusovka:
+
+	//     [16   8   0   0   0   0   0   0   0]
+
+	// Po zpětné transformaci dostaneme signál bez rychlé sinusovky:
+	filtered := mat.NewVecDense(n, fft.Sequence(nil, coefficients))
+	filtered.ScaleVec(1.0/n, filtered)
+	fmt.Printf("%.3f\n", mat.Formatted(filtered.T()))
+
+	// Výsledek:
+
+	//     [1.000  1.383  1.707  1.924  2.000  1.924  1.707  1.383  1.000  0.617  0.293  0.076  0.000  0.076  0.293  0.617]
+
+	// # finito █
+}
@@ -0,0 +1,252 @@
+//go:build example
+
+// # Knihovna Gonum a datové rámce Gota
+
+// ## Úvodní informace
+
+// V úvodní části jsme si řekli, že pro práci s takzvanými "datovými
+// rámci" se ve světě Pythonu používá knihovna **Pandas**. Podobnou
+// funkcionalitu nabízí pro jazyk Go knihovna **Gota** (viz odkazy na konci
+// úvodní části). Datový rámec je tabulka, jejíž sloupce mají jména a mohou
+// obsahovat hodnoty různých typů - řetězce, celá čísla, čísla s plovoucí
+// řádovou čárkou atd. Datové rámce se hodí pro načtení, filtraci a
+// seskupení dat, ovšem pro výpočty s číselnými sloupci je výhodnější
+// použít matice z knihovny **Gonum**. V této části si ukážeme celou
+// analýzu od načtení dat ve formátu CSV přes jejich převod na matici až
+// po převod výsledků zpět do datového rámce.
+
+/*
+Copyright © 2020 Pavel Tisnovsky
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+// Kromě balíčku **mat** budeme potřebovat balíčky **dataframe** a
+// **series** z knihovny **Gota**:
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/go-gota/gota/dataframe"
+	"github.com/go-gota/gota/series"
+	"gonum.org/v1/gonum/mat"
+)
+
+// Analyzovat budeme počty kusů ovoce prodané v šesti prodejnách. Data
+// jsou uložena ve formátu CSV, první řádek obsahuje jména sloupců:
+const sales = `store,region,apples,pears,plums
+Brno,Morava,120,80,40
+Olomouc,Morava,90,60,70
+Praha,Čechy,200,150,30
+Plzeň,Čechy,110,70,20
+Ostrava,Morava,60,50,90
+Liberec,Čechy,80,40,50
+`
+
+// Datový rámec převedeme na matici tak, že do každého sloupce matice
+// zkopírujeme hodnoty ze sloupce datového rámce. Metoda `Float` vrací
+// hodnoty sloupce jako řez `[]float64`, který lze přímo předat metodě
+// `SetCol`:
+func toDense(df dataframe.DataFrame) *mat.Dense {
+	rows, columns := df.Dims()
+	m := mat.NewDense(rows, columns, nil)
+	for j, name := range df.Names() {
+		m.SetCol(j, df.Col(name).Float())
+	}
+	return m
+}
+
+// Všechny další příkazy opět umístíme do funkce **main**:
+func main() {
+	// ## Načtení datového rámce
+
+	// Datový rámec načteme funkcí `dataframe.ReadCSV`. Ta nevrací chybu
+	// jako druhou návratovou hodnotu, chyba je uložena v atributu `Err`
+	// datového rámce. Typy sloupců jsou odvozeny z jejich obsahu:
+	df := dataframe.ReadCSV(strings.NewReader(sales))
+	if df.Err != nil {
+		fmt.Println(df.Err)
+		return
+	}
+	fmt.Println(df)
+
+	// Datový rámec se vypíše ve formě tabulky, pod kterou jsou uvedeny typy
+	// sloupců:
+
+	//     [6x5] DataFrame
+	//
+	//         store    region   apples pears plums
+	//      0: Brno     Morava   120    80    40
+	//      1: Olomouc  Morava   90     60    70
+	//      2: Praha    Čechy    200    150   30
+	//      3: Plzeň    Čechy    110    70    20
+	//      4: Ostrava  Morava   60     50    90
+	//      5: Liberec  Čechy    80     40    50
+	//         <string> <string> <int>  <int> <int>
+
+	// ## Filtrace
+
+	// Řádky vybereme metodou `Filter`, které předáme jméno sloupce,
+	// operátor porovnání a hodnotu, se kterou se porovnává. Vybereme
+	// prodejny, které prodaly více než sto jablek:
+	filtered := df.Filter(dataframe.F{
+		Colname:    "apples",
+		Comparator: series.Greater,
+		Comparando: 100,
+	})
+	fmt.Println(filtered)
+
+	// Výsledek:
+
+	//     [3x5] DataFrame
+	//
+	//         store    region   apples pears plums
+	//      0: Brno     Morava   120    80    40
+	//      1: Praha    Čechy    200    150   30
+	//      2: Plzeň    Čechy    110    70    20
+	//         <string> <string> <int>  <int> <int>
+
+	// ## Seskupení
+
+	// Metoda `GroupBy` rozdělí řádky do skupin podle hodnoty zvoleného
+	// sloupce. Pro každou skupinu pak metodou `Aggregation` vypočteme
+	// agregované hodnoty - zde součty jablek a švestek. Pořadí skupin
+	// není zaručeno, proto výsledek seřadíme metodou `Arrange`:
+	grouped := df.GroupBy("region").
+		Aggregation(
+			[]dataframe.AggregationType{dataframe.Aggregation_SUM, dataframe.Aggregation_SUM},
+			[]string{"apples", "plums"}).
+		Arrange(dataframe.Sort("region"))
+	fmt.Println(grouped)
+
+	// Jména sloupců s agregovanými hodnotami jsou odvozena ze jména
+	// původního sloupce a typu agregace. Součty jsou vždy typu `float`:
+
+	//     [2x3] DataFrame
+	//
+	//         apples_SUM plums_SUM  region
+	//      0: 270.000000 200.000000 Morava
+	//      1: 390.000000 100.000000 Čechy
+	//         <float>    <float>    <string>
+
+	// ## Převod na matici
+
+	// Pro další výpočty vybereme metodou `Select` pouze číselné sloupce a
+	// převedeme je na matici. Každý řádek matice odpovídá jedné prodejně,
+	// každý sloupec jednomu druhu ovoce:
+	units := toDense(df.Select([]string{"apples", "pears", "plums"}))
+	fmt.Println(mat.Formatted(units))
+
+	// Výsledek:
+
+	//     ⎡120   80   40⎤
+	//     ⎢ 90   60   70⎥
+	//     ⎢200  150   30⎥
+	//     ⎢110   70   20⎥
+	//     ⎢ 60   50   90⎥
+	//     ⎣ 80   40   50⎦
+
+	// S maticí můžeme provádět všechny operace, které jsme si ukázali
+	// v předchozích částech. Funkce `mat.Col` vrátí jeden sloupec (prodej
+	// jablek ve všech prodejnách), funkce `mat.Row` jeden řádek (prodej
+	// všech druhů ovoce v Praze) a funkce `mat.Sum` součet všech prvků:
+	fmt.Println(mat.Col(nil, 0, units))
+	fmt.Println(mat.Row(nil, 2, units))
+	fmt.Println(mat.Sum(units))
+
+	// Výsledek:
+
+	//     [120 90 200 110 60 80]
+	//     [200 150 30]
+	//     1410
+
+	// ## Maticový součin
+
+	// Z počtu prodaných kusů a jednotkových cen (v korunách za kus)
+	// vypočteme tržbu každé prodejny jako součin matice a vektoru:
+	prices := mat.NewVecDense(3, []float64{5, 8, 2})
+	var revenue mat.VecDense
+	revenue.MulVec(units, prices)
+	fmt.Println(mat.Formatted(revenue.T()))
+
+	// Výsledek:
+
+	//     [1320  1070  2260  1150   880   820]
+
+	// Pokud nás zajímá i tržba za jednotlivé druhy ovoce, vynásobíme matici
+	// diagonální maticí s cenami na hlavní diagonále. Každý sloupec matice
+	// se tím vynásobí cenou příslušného druhu ovoce:
+	var byFruit mat.Dense
+	byFruit.Mul(units, mat.NewDiagDense(3, []float64{5, 8, 2}))
+	fmt.Println(mat.Formatted(&byFruit))
+
+	// Výsledek:
+
+	//     ⎡ 600   640    80⎤
+	//     ⎢ 450   480   140⎥
+	//     ⎢1000  1200    60⎥
+	//     ⎢ 550   560    40⎥
+	//     ⎢ 300   400   180⎥
+	//     ⎣ 400   320   100⎦
+
+	// ## Převod zpět do datového rámce
+
+	// Vektor s tržbami přidáme do původního datového rámce jako nový
+	// sloupec metodou `Mutate`. Prodejny pak seřadíme od nejvyšší tržby
+	// a vybereme jen sloupce, které nás zajímají:
+	df = df.Mutate(series.New(revenue.RawVector().Data, series.Float, "revenue"))
+	fmt.Println(df.Arrange(dataframe.RevSort("revenue")).Select([]string{"store", "region", "revenue"}))
+
+	// Výsledek:
+
+	//     [6x3] DataFrame
+	//
+	//         store    region   revenue
+	//      0: Praha    Čechy    2260.000000
+	//      1: Brno     Morava   1320.000000
+	//      2: Plzeň    Čechy    1150.000000
+	//      3: Olomouc  Morava   1070.000000
+	//      4: Ostrava  Morava   880.000000
+	//      5: Liberec  Čechy    820.000000
+	//         <string> <string> <float>
+
+	// Celou matici převedeme na datový rámec funkcí `dataframe.LoadMatrix`.
+	// Sloupce nového rámce se jmenují `X0`, `X1` atd., proto je
+	// přejmenujeme a přidáme sloupec se jmény prodejen:
+	revenues := dataframe.LoadMatrix(&byFruit)
+	err := revenues.SetNames("apples", "pears", "plums")
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	revenues = revenues.Mutate(df.Col("store"))
+	fmt.Println(revenues)
+
+	// Výsledek:
+
+	//     [6x4] DataFrame
+	//
+	//         apples      pears       plums      store
+	//      0: 600.000000  640.000000  80.000000  Brno
+	//      1: 450.000000  480.000000  140.000000 Olomouc
+	//      2: 1000.000000 1200.000000 60.000000  Praha
+	//      3: 550.000000  560.000000  40.000000  Plzeň
+	//      4: 300.000000  400.000000  180.000000 Ostrava
+	//      5: 400.000000  320.000000  100.000000 Liberec
+	//         <float>     <float>     <float>    <string>
+
+	// # finito █
+}
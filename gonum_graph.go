@@ -0,0 +1,274 @@
+//go:build example
+
+// # Knihovna Gonum: grafy a matice sousednosti
+
+// ## Úvodní informace
+
+// V úvodní části jsme si řekli, že knihovna **Gonum** obsahuje i podporu
+// pro tvorbu grafů. Grafy (tedy množiny uzlů propojených hranami) a
+// matice spolu úzce souvisí: graf s `n` uzly lze reprezentovat maticí
+// sousednosti o rozměrech `n×n`, v níž prvek na řádku `i` a ve sloupci `j`
+// obsahuje váhu hrany mezi uzly `i` a `j` (nebo nulu, pokud hrana
+// neexistuje). Matice sousednosti neorientovaného grafu je navíc
+// symetrická, takže pro ni můžeme použít typ `SymDense`, se kterým jsme
+// se již setkali v části o symetrických maticích. V této části si
+// ukážeme převod mezi maticí a grafem oběma směry a tři základní algoritmy:
+
+// 1. hledání nejkratších cest Dijkstrovým algoritmem
+// 1. nalezení komponent souvislosti
+// 1. výpočet PageRank pro orientovaný graf
+
+/*
+Copyright © 2020 Pavel Tisnovsky
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+// Grafy jsou rozděleny do většího množství balíčků. Balíček **simple**
+// obsahuje implementace grafů, algoritmy nalezneme v balíčcích **path**,
+// **topo** a **network** a export do formátu DOT v balíčku **dot**:
+
+import (
+	"fmt"
+	"math"
+	"sort"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/encoding"
+	"gonum.org/v1/gonum/graph/encoding/dot"
+	"gonum.org/v1/gonum/graph/network"
+	"gonum.org/v1/gonum/graph/path"
+	"gonum.org/v1/gonum/graph/simple"
+	"gonum.org/v1/gonum/graph/topo"
+	"gonum.org/v1/gonum/mat"
+)
+
+// Hrany grafu budou představovat silnice mezi městy, váhou hrany je
+// délka silnice. Vlastní typ hrany vznikne vložením typu
+// `simple.WeightedEdge`. Metoda `Attributes` zajistí, že se při exportu
+// do formátu DOT u hrany zobrazí i její váha:
+type road struct {
+	simple.WeightedEdge
+}
+
+// Attributes returns attributes of edge used by DOT encoder.
+func (r road) Attributes() []encoding.Attribute {
+	return []encoding.Attribute{{Key: "label", Value: fmt.Sprint(r.W)}}
+}
+
+// Uzly vrácené algoritmy nejsou nijak seřazeny. Pro přehlednější (a
+// hlavně stále stejný) výpis je seřadíme podle jejich identifikátorů:
+func sortedIDs(nodes []graph.Node) []int64 {
+	ids := make([]int64, len(nodes))
+	for i, node := range nodes {
+		ids[i] = node.ID()
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids
+}
+
+// Všechny další příkazy opět umístíme do funkce **main**:
+func main() {
+	// ## Matice sousednosti
+
+	// Začneme symetrickou maticí sousednosti grafu se sedmi uzly. Uzly 0
+	// až 4 jsou propojeny několika hranami, uzly 5 a 6 jsou propojeny
+	// pouze navzájem:
+	adjacency := mat.NewSymDense(7, []float64{
+		0, 7, 9, 0, 0, 0, 0,
+		7, 0, 10, 15, 0, 0, 0,
+		9, 10, 0, 11, 2, 0, 0,
+		0, 15, 11, 0, 6, 0, 0,
+		0, 0, 2, 6, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 3,
+		0, 0, 0, 0, 0, 3, 0,
+	})
+
+	// ## Převod matice na graf
+
+	// Neorientovaný graf s ohodnocenými hranami vytvoříme konstruktorem
+	// `NewWeightedUndirectedGraph`. Jeho parametry určují váhu hrany
+	// vedoucí z uzlu do sebe sama a váhu chybějící hrany. Poté přidáme
+	// všechny uzly a hrany odpovídající nenulovým prvkům matice. Matice je
+	// symetrická, takže stačí projít prvky nad hlavní diagonálou:
+	g := simple.NewWeightedUndirectedGraph(0, math.Inf(1))
+	n := adjacency.SymmetricDim()
+	for i := 0; i < n; i++ {
+		g.AddNode(simple.Node(i))
+	}
+	for i := 0; i < n; i++ {
+		for j := i + 1; j < n; j++ {
+			if w := adjacency.At(i, j); w != 0 {
+				g.SetWeightedEdge(road{simple.WeightedEdge{F: simple.Node(i), T: simple.Node(j), W: w}})
+			}
+		}
+	}
+	fmt.Println("nodes:", g.Nodes().Len())
+	fmt.Println("edges:", g.Edges().Len())
+
+	// Výsledek:
+
+	//     nodes: 7
+	//     edges: 8
+
+	// ## Nejkratší cesty
+
+	// Funkce `path.DijkstraFrom` vypočte nejkratší cesty ze zadaného uzlu
+	// do všech ostatních uzlů. Cestu do konkrétního uzlu a její délku
+	// (součet vah hran) vrací metoda `To`:
+	shortest := path.DijkstraFrom(simple.Node(0), g)
+	for i := 0; i < n; i++ {
+		nodes, weight := shortest.To(int64(i))
+		fmt.Printf("0 -> %d: %v, length %v\n", i, nodes, weight)
+	}
+
+	// Do uzlu 3 nevede nejkratší cesta přímou hranou z uzlu 1 (délka 22),
+	// ale přes uzly 2 a 4. Do uzlů 5 a 6 žádná cesta nevede, proto je
+	// délka nekonečná:
+
+	//     0 -> 0: [0], length 0
+	//     0 -> 1: [0 1], length 7
+	//     0 -> 2: [0 2], length 9
+	//     0 -> 3: [0 2 4 3], length 17
+	//     0 -> 4: [0 2 4], length 11
+	//     0 -> 5: [], length +Inf
+	//     0 -> 6: [], length +Inf
+
+	// ## Komponenty souvislosti
+
+	// Že se graf skládá ze dvou vzájemně nepropojených částí, zjistíme
+	// i funkcí `topo.ConnectedComponents`. Ta vrací řez komponent, každá
+	// komponenta je řezem uzlů:
+	var components [][]int64
+	for _, component := range topo.ConnectedComponents(g) {
+		components = append(components, sortedIDs(component))
+	}
+	sort.Slice(components, func(i, j int) bool { return components[i][0] < components[j][0] })
+	fmt.Println(components)
+
+	// Výsledek:
+
+	//     [[0 1 2 3 4] [5 6]]
+
+	// ## Převod grafu na matici
+
+	// Opačný převod provedeme pomocí typu `UndirectedMatrix`, což je
+	// neorientovaný graf, jehož hrany jsou uloženy přímo v matici
+	// sousednosti. Parametry konstruktoru určují počet uzlů, počáteční váhu
+	// všech hran, váhu hrany z uzlu do sebe sama a váhu chybějící hrany.
+	// Hrany do něj zkopírujeme z původního grafu:
+	dense := simple.NewUndirectedMatrix(n, 0, 0, 0)
+	for edges := g.WeightedEdges(); edges.Next(); {
+		dense.SetWeightedEdge(edges.WeightedEdge())
+	}
+
+	// Metoda `Matrix` vrací matici sousednosti. Pro neorientovaný graf se
+	// jedná o symetrickou matici typu `SymDense`, kterou můžeme porovnat
+	// s původní maticí:
+	sym := dense.Matrix().(*mat.SymDense)
+	fmt.Println(mat.Formatted(sym))
+	fmt.Println(mat.Equal(sym, adjacency))
+
+	// Obě matice jsou shodné:
+
+	//     ⎡ 0   7   9   0   0   0   0⎤
+	//     ⎢ 7   0  10  15   0   0   0⎥
+	//     ⎢ 9  10   0  11   2   0   0⎥
+	//     ⎢ 0  15  11   0   6   0   0⎥
+	//     ⎢ 0   0   2   6   0   0   0⎥
+	//     ⎢ 0   0   0   0   0   0   3⎥
+	//     ⎣ 0   0   0   0   0   3   0⎦
+	//     true
+
+	// ## PageRank
+
+	// Algoritmus PageRank ohodnocuje uzly orientovaného grafu podle toho,
+	// kolik hran do nich vede a jak důležité jsou uzly, ze kterých hrany
+	// vedou. Orientovaný graf čtyř webových stránek zapíšeme nesymetrickou
+	// maticí typu `Dense` - jednička na řádku `i` a ve sloupci `j`
+	// znamená, že stránka `i` obsahuje odkaz na stránku `j`:
+	links := mat.NewDense(4, 4, []float64{
+		0, 1, 1, 0,
+		0, 0, 1, 0,
+		1, 0, 0, 0,
+		0, 0, 1, 0,
+	})
+	rows, columns := links.Dims()
+	web := simple.NewDirectedGraph()
+	for i := 0; i < rows; i++ {
+		for j := 0; j < columns; j++ {
+			if links.At(i, j) != 0 {
+				web.SetEdge(web.NewEdge(simple.Node(i), simple.Node(j)))
+			}
+		}
+	}
+
+	// Funkci `network.PageRank` předáme graf, tlumicí faktor (obvykle se
+	// používá hodnota 0,85) a toleranci, při jejímž dosažení se iterativní
+	// výpočet ukončí. Výsledkem je mapa s hodnocením jednotlivých uzlů:
+	ranks := network.PageRank(web, 0.85, 1e-8)
+	for i := 0; i < rows; i++ {
+		fmt.Printf("page %d: %.4f\n", i, ranks[int64(i)])
+	}
+
+	// Nejvyšší hodnocení má stránka 2, na kterou odkazují všechny ostatní
+	// stránky. Stránka 0 sice má jediný odkaz, ovšem z nejdůležitější
+	// stránky, zatímco na stránku 3 neodkazuje nikdo:
+
+	//     page 0: 0.3725
+	//     page 1: 0.1958
+	//     page 2: 0.3941
+	//     page 3: 0.0375
+
+	// ## Export do formátu DOT
+
+	// Grafy lze exportovat do formátu DOT, se kterým pracuje například
+	// nástroj **Graphviz**. Funkce `dot.Marshal` akceptuje graf, jeho jméno,
+	// prefix všech řádků a řetězec použitý pro odsazení:
+	data, err := dot.Marshal(g, "roads", "", "  ")
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Println(string(data))
+
+	// Uzly i hrany jsou seřazeny, hrany obsahují atribut `label` s vahou
+	// vrácený metodou `Attributes`:
+
+	//     strict graph roads {
+	//       // Node definitions.
+	//       0;
+	//       1;
+	//       2;
+	//       3;
+	//       4;
+	//       5;
+	//       6;
+	//
+	//       // Edge definitions.
+	//       0 -- 1 [label=7];
+	//       0 -- 2 [label=9];
+	//       1 -- 2 [label=10];
+	//       1 -- 3 [label=15];
+	//       2 -- 3 [label=11];
+	//       2 -- 4 [label=2];
+	//       3 -- 4 [label=6];
+	//       5 -- 6 [label=3];
+	//     }
+
+	// Obrázek grafu pak vytvoříme příkazem `dot -Tsvg roads.dot -o roads.svg`.
+
+	// # finito █
+}
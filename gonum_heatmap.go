@@ -0,0 +1,231 @@
+//go:build example
+
+// # Knihovna Gonum: zobrazení matic formou teplotní mapy
+
+// ## Úvodní informace
+
+// Mezi odkazy uvedenými na konci základního textu o knihovně **Gonum** je
+// zmíněn i projekt **gonum/plot**, který slouží pro tvorbu grafů. Jedním z
+// typů grafů, které tento projekt podporuje, je takzvaná *teplotní mapa*
+// (*heatmap*), v níž je hodnota každého prvku matice reprezentována barvou.
+// Jedná se o užitečný doplněk ke *spy* grafům - nyní totiž nezobrazujeme
+// pouze strukturu nenulových prvků, ale i jejich hodnoty. Vedle samotné
+// mapy zobrazíme i barevnou škálu (*color bar*), ze které je patrné, jaké
+// hodnotě daná barva odpovídá.
+
+/*
+Copyright © 2020 Pavel Tisnovsky
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+// Tentokrát budeme potřebovat větší množství balíčků - kromě balíčku
+// **mat** i balíček **stat** (výpočet kovarianční matice) a několik
+// balíčků z projektu **gonum/plot**:
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/stat"
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/palette/moreland"
+	"gonum.org/v1/plot/plotter"
+	"gonum.org/v1/plot/vg"
+	"gonum.org/v1/plot/vg/draw"
+)
+
+// ## Adaptér mezi maticí a teplotní mapou
+
+// Teplotní mapa z balíčku **plotter** neočekává přímo matici, ale hodnotu
+// splňující rozhraní `plotter.GridXYZ`. To vyžaduje metody `Dims`, `Z`,
+// `X` a `Y`. Povšimněte si, že u mřížky se nejdříve uvádí sloupec a
+// teprve poté řádek, tedy přesně naopak, než u matic. Navíc osa *y*
+// směřuje nahoru, takže první řádek matice musí ležet nejvýše.
+type matrixGrid struct {
+	m mat.Matrix
+}
+
+func (g matrixGrid) Dims() (c, r int) {
+	r, c = g.m.Dims()
+	return c, r
+}
+
+func (g matrixGrid) Z(c, r int) float64 {
+	rows, _ := g.m.Dims()
+	return g.m.At(rows-1-r, c)
+}
+
+func (g matrixGrid) X(c int) float64 {
+	return float64(c)
+}
+
+func (g matrixGrid) Y(r int) float64 {
+	return float64(r)
+}
+
+// ## Vykreslení teplotní mapy s barevnou škálou
+
+// Samotná funkce pro vykreslení je nejdelší částí tohoto příkladu.
+// Teplotní mapa a barevná škála jsou vykresleny do dvou samostatných grafů,
+// které sdílí jedno plátno (*canvas*). Formát výsledného obrázku (PNG,
+// SVG, PDF...) je odvozen od koncovky jména souboru.
+func heatmap(m mat.Matrix, title string, filename string) error {
+	colors := moreland.SmoothBlueRed()
+	h := plotter.NewHeatMap(matrixGrid{m}, colors.Palette(255))
+
+	// Rozsah barevné škály musí odpovídat rozsahu hodnot v matici
+	colors.SetMin(h.Min)
+	colors.SetMax(h.Max)
+	if h.Min == h.Max {
+		colors.SetMax(h.Min + 1)
+	}
+
+	p := plot.New()
+	p.Title.Text = title
+	p.HideAxes()
+	p.Add(h)
+
+	bar := plot.New()
+	bar.HideX()
+	bar.Y.Padding = 0
+	bar.Add(&plotter.ColorBar{ColorMap: colors, Vertical: true})
+
+	// Plátno rozdělíme na dvě části - teplotní mapa zabere větší část
+	// plochy, barevná škála zbytek
+	const width = 12 * vg.Centimeter
+	const height = 10 * vg.Centimeter
+	const barWidth = 2 * vg.Centimeter
+
+	format := strings.TrimPrefix(filepath.Ext(filename), ".")
+	canvas, err := draw.NewFormattedCanvas(width, height, format)
+	if err != nil {
+		return err
+	}
+	dc := draw.New(canvas)
+	p.Draw(draw.Crop(dc, 0, -barWidth, 0, 0))
+	bar.Draw(draw.Crop(dc, width-barWidth, 0, vg.Centimeter, -vg.Centimeter))
+
+	f, err := os.Create(filename)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	_, err = canvas.WriteTo(f)
+	return err
+}
+
+// Obrázky ukládáme stejně jako v textu o zobrazení
+// [struktury řídkých matic](gonum_spy.html), tedy do adresáře
+// s vygenerovanou dokumentací:
+func outputFile(name string) string {
+	dir := os.Getenv("LITERATE_OUTPUT")
+	if dir == "" {
+		dir = "docs"
+	}
+	return filepath.Join(dir, name)
+}
+
+// Všechny další příkazy opět umístíme do funkce **main**:
+func main() {
+	// ## Velká řídká matice
+
+	// Začneme opět jednotkovou maticí o rozměrech 100x100 prvků, do které
+	// pro zajímavost přidáme i několik prvků s odlišnými hodnotami:
+	big := mat.NewDense(100, 100, nil)
+	for i := 0; i < 100; i++ {
+		big.Set(i, i, 1)
+	}
+	for i := 0; i < 100; i += 10 {
+		big.Set(i, 99-i, -float64(i)/10)
+	}
+
+	// Teplotní mapu uložíme ve formátu PNG, protože ve formátu SVG by
+	// obsahovala samostatný obdélník pro každý z deseti tisíc prvků:
+	err := heatmap(big, "big", outputFile("heatmap_big.png"))
+	if err != nil {
+		fmt.Println(err)
+	}
+
+	// ![Teplotní mapa matice big](heatmap_big.png)
+
+	// ## Výsledek maticového součinu
+
+	// Dále zobrazíme výsledek maticového součinu `d.Mul(m2, m3)` ze
+	// základního textu:
+	m2 := mat.NewDense(3, 4, []float64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12})
+	m3 := m2.T()
+
+	var d mat.Dense
+	d.Mul(m2, m3)
+	fmt.Println(mat.Formatted(&d))
+
+	// Připomeňme si, že výsledkem je symetrická matice:
+
+	//     ⎡ 30   70  110⎤
+	//     ⎢ 70  174  278⎥
+	//     ⎣110  278  446⎦
+
+	// Tentokrát si obrázek uložíme ve formátu PNG:
+	err = heatmap(&d, "d.Mul(m2, m3)", outputFile("heatmap_mul.png"))
+	if err != nil {
+		fmt.Println(err)
+	}
+
+	// ![Teplotní mapa výsledku maticového součinu](heatmap_mul.png)
+
+	// ## Kovarianční matice
+
+	// Teplotní mapy se velmi často používají pro zobrazení kovariančních
+	// nebo korelačních matic. Každý řádek vstupní matice představuje jedno
+	// měření, každý sloupec jednu sledovanou veličinu:
+	data := mat.NewDense(6, 4, []float64{
+		1, 2, 3, 1,
+		2, 4, 1, 1,
+		3, 6, 4, 2,
+		4, 8, 1, 1,
+		5, 10, 5, 2,
+		6, 12, 2, 1,
+	})
+
+	// Kovarianční matice je symetrická, proto ji uložíme do matice typu
+	// `SymDense`:
+	var cov mat.SymDense
+	stat.CovarianceMatrix(&cov, data, nil)
+	fmt.Printf("%.3f\n\n", mat.Formatted(&cov))
+
+	// Výsledek:
+
+	//     ⎡ 3.500   7.000   0.400   0.200⎤
+	//     ⎢ 7.000  14.000   0.800   0.400⎥
+	//     ⎢ 0.400   0.800   2.667   0.733⎥
+	//     ⎣ 0.200   0.400   0.733   0.267⎦
+
+	err = heatmap(&cov, "kovarianční matice", outputFile("heatmap_cov.svg"))
+	if err != nil {
+		fmt.Println(err)
+	}
+
+	// ![Teplotní mapa kovarianční matice](heatmap_cov.svg)
+
+	// Na první pohled je patrné, že první dvě veličiny jsou silně
+	// korelované (druhý sloupec je dvojnásobkem prvního sloupce).
+
+	// # finito █
+}
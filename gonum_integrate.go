@@ -0,0 +1,220 @@
+//go:build example
+
+// # Knihovna Gonum: numerická integrace a interpolace
+
+// ## Úvodní informace
+
+// Velmi často se setkáme s funkcemi, které nejsou zadány vzorcem, ale
+// pouze svými hodnotami v několika bodech - typicky se jedná o výsledky
+// měření nebo simulací. S takovými funkcemi potřebujeme provádět dvě
+// základní operace: vypočítat jejich (určitý) integrál a odhadnout
+// jejich hodnoty mezi naměřenými body. K tomu slouží dva balíčky
+// knihovny **Gonum**:
+
+// 1. **integrate** - integrace funkcí zadaných hodnotami (lichoběžníková a Simpsonova metoda), podbalíček **quad** integruje funkce zadané v Go
+// 1. **interp** - interpolace po částech lineární funkcí a různými typy splajnů
+
+/*
+Copyright © 2020 Pavel Tisnovsky
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+// Kromě balíčků **fmt**, **math** a **mat** použijeme balíčky
+// **integrate**, **quad** a **interp** a také balíček **floats** s
+// pomocnými funkcemi pro práci s řezy:
+
+import (
+	"fmt"
+	"math"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/integrate"
+	"gonum.org/v1/gonum/integrate/quad"
+	"gonum.org/v1/gonum/interp"
+	"gonum.org/v1/gonum/mat"
+)
+
+// Všechny další příkazy opět umístíme do funkce **main**:
+func main() {
+	// ## Vzorky funkce
+
+	// Jako body, v nichž známe hodnoty funkcí, použijeme vektor
+	// s hodnotami 1 až 10, se kterým jsme pracovali již v úvodní části.
+	// Funkce z balíčků **integrate** a **interp** ovšem pracují přímo
+	// s řezy typu `[]float64`. Řez s prvky vektoru získáme metodou
+	// `RawVector`, která prvky nekopíruje:
+	v := mat.NewVecDense(10, []float64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10})
+	xs := v.RawVector().Data
+
+	// První funkcí bude `x²`. Její hodnoty v zadaných bodech vypočteme
+	// vynásobením korespondujících prvků vektoru:
+	var squares mat.VecDense
+	squares.MulElemVec(v, v)
+	fmt.Println(mat.Formatted(squares.T()))
+
+	// Výsledek:
+
+	//     [  1    4    9   16   25   36   49   64   81  100]
+
+	// ## Lichoběžníková a Simpsonova metoda
+
+	// Integrál funkce `x²` v intervalu od 1 do 10 je roven `(10³ - 1³) / 3 =
+	// 333`. Lichoběžníková metoda nahrazuje funkci mezi sousedními body
+	// úsečkou, Simpsonova metoda parabolou. Obě funkce akceptují řez
+	// s body a řez s hodnotami funkce v těchto bodech (body nemusí být
+	// rozmístěny rovnoměrně):
+	ys := squares.RawVector().Data
+	exact := 333.0
+	trapezoidal := integrate.Trapezoidal(xs, ys)
+	simpsons := integrate.Simpsons(xs, ys)
+	fmt.Printf("trapezoidal: %.6f  error: %.2e\n", trapezoidal, trapezoidal-exact)
+	fmt.Printf("Simpson:     %.6f  error: %.2e\n", simpsons, simpsons-exact)
+
+	// Simpsonova metoda je pro polynomy do třetího stupně přesná, chyba je
+	// způsobena pouze zaokrouhlováním:
+
+	//     trapezoidal: 334.500000  error: 1.50e+00
+	//     Simpson:     333.000000  error: -5.68e-14
+
+	// Zajímavější je funkce `sin(x)`, jejíž integrál v intervalu od 1 do
+	// 10 je roven `cos(1) - cos(10)`:
+	sines := make([]float64, len(xs))
+	for i, x := range xs {
+		sines[i] = math.Sin(x)
+	}
+	exact = math.Cos(1) - math.Cos(10)
+	trapezoidal = integrate.Trapezoidal(xs, sines)
+	simpsons = integrate.Simpsons(xs, sines)
+	fmt.Printf("exact:       %.6f\n", exact)
+	fmt.Printf("trapezoidal: %.6f  error: %.2e\n", trapezoidal, trapezoidal-exact)
+	fmt.Printf("Simpson:     %.6f  error: %.2e\n", simpsons, simpsons-exact)
+
+	// Deset vzorků je pro funkci sinus poměrně málo, obě metody se proto
+	// dopouštějí znatelné chyby:
+
+	//     exact:       1.379374
+	//     trapezoidal: 1.262463  error: -1.17e-01
+	//     Simpson:     1.426192  error: 4.68e-02
+
+	// ## Závislost chyby na počtu vzorků
+
+	// Pokud zvětšíme počet vzorků, chyba se zmenší. Rovnoměrně rozmístěné
+	// body vytvoříme funkcí `floats.Span`, která naplní řez hodnotami od
+	// zadaného minima do maxima. Vzdálenost bodů budeme postupně zmenšovat
+	// na polovinu:
+	fmt.Println("   n  trapezoidal    Simpson")
+	for _, n := range []int{10, 19, 37, 73, 145} {
+		x := floats.Span(make([]float64, n), 1, 10)
+		y := make([]float64, n)
+		for i := range x {
+			y[i] = math.Sin(x[i])
+		}
+		fmt.Printf("%4d %12.2e %10.2e\n", n,
+			integrate.Trapezoidal(x, y)-exact, integrate.Simpsons(x, y)-exact)
+	}
+
+	// Chyba lichoběžníkové metody klesá se čtvercem vzdálenosti bodů (při
+	// poloviční vzdálenosti je chyba čtyřikrát menší), chyba Simpsonovy
+	// metody se čtvrtou mocninou (je přibližně šestnáctkrát menší):
+
+	//        n  trapezoidal    Simpson
+	//       10    -1.17e-01   4.68e-02
+	//       19    -2.89e-02   4.94e-04
+	//       37    -7.19e-03   3.02e-05
+	//       73    -1.80e-03   1.87e-06
+	//      145    -4.49e-04   1.17e-07
+
+	// ## Integrace funkce zadané v Go
+
+	// Pokud funkci umíme vypočítat v libovolném bodě, můžeme použít
+	// podbalíček **quad**. Funkce `quad.Fixed` integruje funkci
+	// s pevným počtem bodů, které jsou zvoleny podle zadaného pravidla.
+	// Výchozím pravidlem (`nil`) je Gaussova-Legendrova kvadratura. Poslední
+	// parametr určuje počet gorutin, nula znamená výpočet v jediné
+	// gorutině:
+	gauss := quad.Fixed(math.Sin, 1, 10, 10, nil, 0)
+	fmt.Printf("Gauss-Legendre: %.10f  error: %.2e\n", gauss, gauss-exact)
+
+	// Se stejným počtem výpočtů funkce, jaký jsme použili u
+	// lichoběžníkové metody, dostaneme výsledek přesný na deset
+	// desetinných míst:
+
+	//     Gauss-Legendre: 1.3793738350  error: 3.51e-11
+
+	// ## Interpolace
+
+	// Balíček **interp** obsahuje několik typů interpolace, které
+	// implementují rozhraní `FittablePredictor`. Metodou `Fit` se
+	// interpolace vypočte ze zadaných bodů a hodnot, metodou `Predict`
+	// pak získáme hodnotu v libovolném bodě intervalu. Porovnáme interpolaci
+	// po částech lineární funkcí (`PiecewiseLinear`) a Akimův splajn
+	// (`AkimaSpline`), který je méně náchylný k zákmitům než klasický
+	// kubický splajn:
+	var linear interp.PiecewiseLinear
+	err := linear.Fit(xs, sines)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	var akima interp.AkimaSpline
+	err = akima.Fit(xs, sines)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Println("    x     sin(x)     linear      Akima")
+	for _, x := range []float64{1.5, 2.25, 5.5, 7.75, 9.9} {
+		fmt.Printf("%5.2f %10.6f %10.6f %10.6f\n", x, math.Sin(x), linear.Predict(x), akima.Predict(x))
+	}
+
+	// V bodech ležících mezi vzorky se lineární interpolace od skutečné
+	// hodnoty funkce odchyluje více než splajn:
+
+	//         x     sin(x)     linear      Akima
+	//      1.50   0.997495   0.875384   1.018096
+	//      2.25   0.778073   0.717253   0.736366
+	//      5.50  -0.705540  -0.619170  -0.667942
+	//      7.75   0.994599   0.906265   1.006950
+	//      9.90  -0.457536  -0.448407  -0.432059
+
+	// Maximální chybu obou interpolací zjistíme porovnáním se skutečnou
+	// funkcí v jemné síti bodů. Funkce `maxError` akceptuje libovolný
+	// typ implementující rozhraní `Predictor`:
+	maxError := func(p interp.Predictor) float64 {
+		maximum := 0.0
+		for _, x := range floats.Span(make([]float64, 901), 1, 10) {
+			maximum = math.Max(maximum, math.Abs(p.Predict(x)-math.Sin(x)))
+		}
+		return maximum
+	}
+	fmt.Printf("linear: %.4f\n", maxError(&linear))
+	fmt.Printf("Akima:  %.4f\n", maxError(&akima))
+
+	// Výsledek:
+
+	//     linear: 0.1221
+	//     Akima:  0.0510
+
+	// Splajn navíc umožňuje vypočítat i derivaci interpolované funkce.
+	// Derivací funkce sinus je kosinus:
+	fmt.Printf("%.6f %.6f\n", akima.PredictDerivative(5.5), math.Cos(5.5))
+
+	// Výsledek:
+
+	//     0.700830 0.708670
+
+	// # finito █
+}
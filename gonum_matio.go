@@ -0,0 +1,251 @@
+//go:build example
+
+// # Knihovna Gonum: načítání a ukládání matic
+
+// ## Úvodní informace
+
+// Ve všech předchozích příkladech byly prvky matic zapsány přímo ve
+// zdrojovém kódu formou řezu `[]float64{1, 2, 3, ...}`. V praxi však
+// většinou potřebujeme zpracovat data uložená v souborech, popř. si
+// matice vyměňovat s jinými nástroji - například s **NumPy**, **SciPy**
+// nebo **Matlabem**. Knihovna **Gonum** sice obsahuje metody
+// `MarshalBinary` a `UnmarshalBinary`, ovšem ty používají vlastní binární
+// formát, kterému ostatní nástroje nerozumí. Proto v tomto repositáři
+// nalezneme balíček **matio**, který podporuje tři rozšířené formáty:
+
+// 1. CSV - textový formát s hodnotami oddělenými čárkami
+// 1. MatrixMarket (`.mtx`) - textový formát používaný pro výměnu řídkých i hustých matic
+// 1. NumPy (`.npy` a `.npz`) - binární formát knihovny **NumPy**
+
+/*
+Copyright © 2020 Pavel Tisnovsky
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+// Kromě balíčků **fmt** a **mat** použijeme i balíček **matio** a několik
+// balíčků ze standardní knihovny:
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/tisnik/literate-programming-examples/matio"
+	"gonum.org/v1/gonum/mat"
+)
+
+// Všechny další příkazy opět umístíme do funkce **main**:
+func main() {
+	// ## Formát CSV
+
+	// Začneme formátem CSV. Matici načteme přímo z řetězce, ovšem namísto
+	// něj lze pochopitelně použít i otevřený soubor. Druhým parametrem
+	// funkce `ReadCSV` určujeme, zda první řádek obsahuje názvy sloupců:
+	input := `x,y,z
+1,2,3
+4,5,6
+7,8,9
+10,11,12`
+	m1, header, err := matio.ReadCSV(strings.NewReader(input), true)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Println(header)
+	fmt.Println(mat.Formatted(m1))
+
+	// Výsledkem je matice se čtyřmi řádky a třemi sloupci:
+
+	//     [x y z]
+	//     ⎡ 1   2   3⎤
+	//     ⎢ 4   5   6⎥
+	//     ⎢ 7   8   9⎥
+	//     ⎣10  11  12⎦
+
+	// Zápis do formátu CSV je stejně snadný. Zapíšeme transponovanou
+	// matici, tentokrát bez hlavičky:
+	var buffer bytes.Buffer
+	err = matio.WriteCSV(&buffer, m1.T(), nil)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Print(buffer.String())
+
+	// S výsledkem:
+
+	//     1,4,7,10
+	//     2,5,8,11
+	//     3,6,9,12
+
+	// ## Formát MatrixMarket
+
+	// Formát MatrixMarket rozlišuje dva způsoby uložení matic. Ve formátu
+	// *array* jsou uloženy všechny prvky matice (po sloupcích), ve
+	// formátu *coordinate* pouze nenulové prvky společně s jejich indexy.
+	// Navíc lze u symetrických matic uložit pouze prvky v dolním
+	// trojúhelníku. Podle hlavičky souboru vrací funkce
+	// `ReadMatrixMarket` matici odpovídajícího typu - například pro
+	// symetrickou matici uloženou v souřadnicovém formátu se jedná o
+	// matici typu `SymDense`:
+	symmetric := `%%MatrixMarket matrix coordinate real symmetric
+% symetrická matice 3x3 se čtyřmi nenulovými prvky v dolním trojúhelníku
+3 3 4
+1 1 1
+2 1 2
+2 2 5
+3 3 9`
+	m2, err := matio.ReadMatrixMarket(strings.NewReader(symmetric))
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Printf("%T\n", m2)
+	fmt.Println(mat.Formatted(m2))
+
+	// Prvek na souřadnicích (1, 2) byl doplněn automaticky:
+
+	//     *mat.SymDense
+	//     ⎡1  2  0⎤
+	//     ⎢2  5  0⎥
+	//     ⎣0  0  9⎦
+
+	// Obecná matice v souřadnicovém formátu je načtena do řídké matice
+	// typu `matio.Sparse`. Knihovna **Gonum** sice řídké matice přímo
+	// nepodporuje, ovšem tento typ implementuje rozhraní `mat.Matrix`,
+	// takže ho můžeme použít ve všech maticových operacích:
+	sparse := `%%MatrixMarket matrix coordinate real general
+4 5 3
+1 1 10
+2 5 20
+4 3 30`
+	m3, err := matio.ReadMatrixMarket(strings.NewReader(sparse))
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Println(mat.Formatted(m3))
+
+	// Výsledek:
+
+	//     ⎡10   0   0   0   0⎤
+	//     ⎢ 0   0   0   0  20⎥
+	//     ⎢ 0   0   0   0   0⎥
+	//     ⎣ 0   0  30   0   0⎦
+
+	// Při zápisu je formát zvolen podle typu matice. Symetrická matice
+	// bude uložena ve formátu *array*, ovšem pouze prvky v dolním
+	// trojúhelníku:
+	buffer.Reset()
+	err = matio.WriteMatrixMarket(&buffer, m2)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Print(buffer.String())
+
+	// Výsledek:
+
+	//     %%MatrixMarket matrix array real symmetric
+	//     3 3
+	//     1
+	//     2
+	//     0
+	//     5
+	//     0
+	//     9
+
+	// ## Formát NumPy
+
+	// Pro výměnu dat s nástroji naprogramovanými v Pythonu je
+	// nejvýhodnější použít přímo nativní formát knihovny **NumPy**.
+	// Soubory `.npy` obsahují jediné pole, které lze v Pythonu načíst
+	// funkcí `numpy.load`. Matici uložíme do dočasného souboru:
+	filename := filepath.Join(os.TempDir(), "matrix.npy")
+	f, err := os.Create(filename)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	err = matio.WriteNPY(f, m1)
+	f.Close()
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	// A následně ji opět načteme:
+	f, err = os.Open(filename)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	m4, err := matio.ReadNPY(f)
+	f.Close()
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Println(mat.Equal(m1, m4))
+
+	// Obě matice jsou shodné:
+
+	//     true
+
+	// Jednorozměrná pole jsou načtena do vektorů typu `VecDense`:
+	buffer.Reset()
+	err = matio.WriteNPY(&buffer, mat.NewVecDense(3, []float64{1, 2, 3}))
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	v, err := matio.ReadNPY(&buffer)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Printf("%T\n", v)
+
+	// Výsledek:
+
+	//     *mat.VecDense
+
+	// Archiv `.npz` (vytvářený funkcemi `numpy.savez` a
+	// `numpy.savez_compressed`) obsahuje větší množství pojmenovaných
+	// polí:
+	buffer.Reset()
+	err = matio.WriteNPZ(&buffer, map[string]mat.Matrix{"m1": m1, "m2": m2}, true)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	arrays, err := matio.ReadNPZ(bytes.NewReader(buffer.Bytes()), int64(buffer.Len()))
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Println(mat.Formatted(arrays["m2"]))
+
+	// Výsledek:
+
+	//     ⎡1  2  0⎤
+	//     ⎢2  5  0⎥
+	//     ⎣0  0  9⎦
+
+	// # finito █
+}
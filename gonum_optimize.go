@@ -0,0 +1,223 @@
+//go:build example
+
+// # Knihovna Gonum: hledání minima funkce (balíček optimize)
+
+// ## Úvodní informace
+
+// V předchozích částech jsme se zabývali prakticky výhradně balíčkem
+// **mat**, tedy maticemi a vektory. Knihovna **Gonum** však obsahuje
+// i další balíčky, které na něj navazují. Jedním z nich je balíček
+// **optimize**, který slouží k nalezení lokálního minima funkce více
+// proměnných. Uživatel pouze dodá samotnou funkci (a pokud je to možné,
+// i její gradient) a počáteční odhad; zbytek práce zařídí zvolená
+// optimalizační metoda. Ukážeme si tři metody:
+
+// 1. BFGS - kvazinewtonovská metoda, která z gradientů postupně odhaduje Hessovu matici
+// 1. LBFGS - varianta BFGS s omezenou pamětí, vhodná pro funkce s velkým množstvím proměnných
+// 1. Nelder-Mead - simplexová metoda, která gradient vůbec nepotřebuje
+
+/*
+Copyright © 2020 Pavel Tisnovsky
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+// Kromě balíčků **fmt** a **mat** budeme potřebovat i balíček **optimize**:
+
+import (
+	"fmt"
+
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/optimize"
+)
+
+// ## Rosenbrockova funkce
+
+// Klasickým testem optimalizačních metod je Rosenbrockova funkce dvou
+// proměnných `f(x, y) = (1 - x)² + 100 (y - x²)²`. Její minimum leží v bodě
+// `[1, 1]` na dně dlouhého, úzkého a mírně zakřiveného údolí. Do údolí se
+// dostaneme snadno, ovšem nalezení minima na jeho dně už je pro mnoho
+// metod obtížné. Funkce, kterou chceme minimalizovat, akceptuje řez
+// s hodnotami proměnných a vrací jedinou hodnotu:
+func rosenbrock(x []float64) float64 {
+	a := 1 - x[0]
+	b := x[1] - x[0]*x[0]
+	return a*a + 100*b*b
+}
+
+// Gradient (tedy vektor parciálních derivací) se nevrací, ale zapisuje
+// do předaného řezu. Díky tomu se při každém volání nemusí alokovat
+// paměť:
+func rosenbrockGrad(grad, x []float64) {
+	b := x[1] - x[0]*x[0]
+	grad[0] = -2*(1-x[0]) - 400*x[0]*b
+	grad[1] = 200 * b
+}
+
+// Všechny další příkazy umístíme do funkce **main**:
+func main() {
+	// ## Metoda BFGS
+
+	// Funkci i její gradient předáme ve struktuře `Problem`. Minimum
+	// budeme hledat z tradičního počátečního bodu `[-1.2, 1]`. Třetím
+	// parametrem funkce `Minimize` jsou nastavení (`nil` znamená výchozí
+	// nastavení), čtvrtým parametrem je použitá metoda:
+	problem := optimize.Problem{
+		Func: rosenbrock,
+		Grad: rosenbrockGrad,
+	}
+	x0 := []float64{-1.2, 1}
+	result, err := optimize.Minimize(problem, x0, nil, &optimize.BFGS{})
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Printf("x = %.6f\n", result.X)
+	fmt.Printf("f(x) = %.2e\n", result.F)
+	fmt.Println(result.Status)
+
+	// Nalezené minimum skutečně leží v bodě `[1, 1]`, hodnota funkce je
+	// (až na zaokrouhlovací chyby) nulová. Stav `GradientThreshold`
+	// znamená, že výpočet skončil proto, že velikost gradientu klesla pod
+	// zvolenou mez:
+
+	//     x = [1.000000 1.000000]
+	//     f(x) = 1.84e-29
+	//     GradientThreshold
+
+	// Výsledek obsahuje i statistiky o průběhu výpočtu - počet iterací a
+	// počet výpočtů hodnoty funkce a jejího gradientu:
+	fmt.Println("iterations:     ", result.MajorIterations)
+	fmt.Println("function evals: ", result.FuncEvaluations)
+	fmt.Println("gradient evals: ", result.GradEvaluations)
+
+	// Výsledek:
+
+	//     iterations:      41
+	//     function evals:  59
+	//     gradient evals:  47
+
+	// ## Porovnání metod BFGS, LBFGS a Nelder-Mead
+
+	// Metody jsou představovány hodnotami typů implementujících rozhraní
+	// `Method`, takže je můžeme uložit do řezu a postupně použít pro
+	// stejný problém. Metoda Nelder-Mead gradient nepoužívá, i když je
+	// v problému uveden:
+	methods := []struct {
+		name   string
+		method optimize.Method
+	}{
+		{"BFGS", &optimize.BFGS{}},
+		{"LBFGS", &optimize.LBFGS{}},
+		{"NelderMead", &optimize.NelderMead{}},
+	}
+	fmt.Println("method       x                       f(x)  iter  func  grad")
+	for _, m := range methods {
+		result, err := optimize.Minimize(problem, x0, nil, m.method)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Printf("%-12s %.6f %9.2e %5d %5d %5d\n", m.name, result.X, result.F,
+			result.MajorIterations, result.FuncEvaluations, result.GradEvaluations)
+	}
+
+	// Všechny tři metody nalezly stejné minimum. Metoda LBFGS potřebovala
+	// nejmenší počet výpočtů funkce, metoda Nelder-Mead naopak potřebovala
+	// přibližně desetkrát více výpočtů, ovšem žádný výpočet gradientu.
+	// To se hodí pro funkce, jejichž gradient neznáme, nebo ho lze spočítat
+	// jen obtížně:
+
+	//     method       x                       f(x)  iter  func  grad
+	//     BFGS         [1.000000 1.000000]  1.84e-29    41    59    47
+	//     LBFGS        [1.000000 1.000000]  1.15e-26    36    46    37
+	//     NelderMead   [1.000000 1.000000]  4.93e-32   206   436     0
+
+	// ## Metoda nejmenších čtverců
+
+	// Minimalizovat můžeme i funkce, které jsou vyjádřeny pomocí matic a
+	// vektorů. Typickým příkladem je metoda nejmenších čtverců, kdy pro
+	// přeurčenou soustavu rovnic `A x = b` hledáme vektor `x`, pro nějž
+	// je součet čtverců reziduí `|A x - b|²` minimální. Jako matici `A`
+	// použijeme matici `dense2` z úvodní části, ovšem se změněným
+	// posledním prvkem - sloupce původní matice jsou totiž lineárně
+	// závislé, takže by řešení nebylo jednoznačné:
+	a := mat.NewDense(4, 3, []float64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 13})
+	b := mat.NewVecDense(4, []float64{1, 0, 2, 1})
+
+	// Funkce i její gradient `2 Aᵀ(A x - b)` se vypočtou operacemi nad
+	// maticemi a vektory. Vektor reziduí `r` je sdílen oběma funkcemi, aby
+	// se nemusel pro každé volání alokovat znovu. Řez `grad` obalíme
+	// vektorem, takže výsledek metody `MulVec` se zapíše přímo do něj:
+	var r mat.VecDense
+	residuals := func(x []float64) {
+		r.MulVec(a, mat.NewVecDense(len(x), x))
+		r.SubVec(&r, b)
+	}
+	leastSquares := optimize.Problem{
+		Func: func(x []float64) float64 {
+			residuals(x)
+			return mat.Dot(&r, &r)
+		},
+		Grad: func(grad, x []float64) {
+			residuals(x)
+			g := mat.NewVecDense(len(grad), grad)
+			g.MulVec(a.T(), &r)
+			g.ScaleVec(2, g)
+		},
+	}
+
+	// Matice `A` je špatně podmíněná, takže výchozí mez velikosti
+	// gradientu (`1e-12`) je příliš přísná a hledání by skončilo chybou
+	// při hledání na přímce (line search). Mez proto v nastaveních zvýšíme:
+	settings := &optimize.Settings{GradientThreshold: 1e-8}
+	fmt.Println("method       x                                f(x)  iter  func  grad")
+	for _, m := range methods {
+		result, err := optimize.Minimize(leastSquares, make([]float64, 3), settings, m.method)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Printf("%-12s %.6f %.6f %5d %5d %5d\n", m.name, result.X, result.F,
+			result.MajorIterations, result.FuncEvaluations, result.GradEvaluations)
+	}
+
+	// Výsledky:
+
+	//     method       x                                f(x)  iter  func  grad
+	//     BFGS         [-1.166667 2.333333 -1.000000] 1.500000    22    31    28
+	//     LBFGS        [-1.166667 2.333333 -1.000000] 1.500000    11    21    18
+	//     NelderMead   [-1.166667 2.333333 -1.000000] 1.500000   211   459     0
+
+	// Pro tuto úlohu ovšem optimalizaci vlastně nepotřebujeme. Metoda
+	// `SolveVec` totiž pro nečtvercové matice vrací právě řešení ve smyslu
+	// nejmenších čtverců (vypočtené pomocí QR rozkladu). Obě řešení se
+	// shodují:
+	var x mat.VecDense
+	err = x.SolveVec(a, b)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Printf("%.6f\n", mat.Formatted(&x))
+
+	// Výsledek:
+
+	//     ⎡-1.166667⎤
+	//     ⎢ 2.333333⎥
+	//     ⎣-1.000000⎦
+
+	// # finito █
+}
@@ -1,3 +1,5 @@
+//go:build example
+
 // # Knihovna Gonum
 
 // ## Úvodní informace o knihovně Gonum
@@ -99,7 +101,7 @@ func main() {
 	// přehledný:
 	fmt.Println(big)
 	/*
-	   {{100 100 [1 0 0 0 0 0 0 0 0 0 0 0 0 0 0
+	   &{{100 100 [1 0 0 0 0 0 0 0 0 0 0 0 0 0 0
 	   ...
 	   ...
 	   ...
@@ -313,7 +315,7 @@ func main() {
 	fmt.Println(v.Dims())
 
 	// Pochopitelně je možné vytvořit i řádkový vektor o to maticovou operací transpozice zapisovanou metodou se jménem `T`
-	vt := v.T()
+	vt := v2.T()
 	fmt.Println(mat.Formatted(vt))
 
 	// S tímto výsledkem
@@ -355,7 +357,7 @@ func main() {
 	// zatímco druhý prvek "kromě" (uzavřený vs. otevřený interval).
 
 	// Podobně lze vytvořit řez obsahující všechny původní prvky
-	vcopy := v.SliceVec(0, 9)
+	vcopy := v10.SliceVec(0, 9)
 	fmt.Println(mat.Formatted(vcopy))
 
 	// Výsledkem by měl být vektor se stejnými prvky jako vektor původní
@@ -464,15 +466,15 @@ func main() {
 		fmt.Printf("%10.6f\n", w.AtVec(i))
 	}
 	/*
-	   ⎡  1⎤
-	   ⎢  2⎥
-	   ⎢  3⎥
-	   ⎢  4⎥
-	   ⎢  5⎥
-	   ⎢100⎥
-	   ⎢  7⎥
-	   ⎢  8⎥
-	   ⎣  9⎦
+	     1.000000
+	     2.000000
+	     3.000000
+	     4.000000
+	     5.000000
+	   100.000000
+	     7.000000
+	     8.000000
+	     9.000000
 	*/
 
 	// ## Další podporované operace nad vektory
@@ -823,15 +825,14 @@ func main() {
 
 	// A opět jsou k dispozici metody pro získání základních informací o
 	// existující matici
-	d2.Diag()
+	fmt.Println(d2.Diag())
 	/*
-	   10      // int
+	   10
 	*/
 
-	d2.Dims()
+	fmt.Println(d2.Dims())
 	/*
-	   10      // int
-	   10      // int
+	   10 10
 	*/
 
 	// Pro nastavení hodnoty prvku diagonální matice se používá metoda
@@ -921,8 +922,10 @@ func main() {
 	t3 := mat.NewTriDense(3, mat.Upper, []float64{1, 2, 3, 4, 5, 6, 7, 8, 9})
 	// toto provést nelze nelze: t3.SetTri(2, 0, 100)
 	// vedlo by k chybě při běhu:
-
-	//     mat: triangular set out of bounds
+	//
+	// ```
+	// mat: triangular set out of bounds
+	// ```
 
 	// Prvek ve třetím sloupci a na prvním řádku naopak změnit bez problémů
 	// lze, protože se jedná o horní trojúhelníkovou matici
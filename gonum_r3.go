@@ -0,0 +1,249 @@
+//go:build example
+
+// # Knihovna Gonum: rotace v trojrozměrném prostoru
+
+// ## Úvodní informace
+
+// V části o součinu matice a vektoru jsme vektor otočili okolo osy `z`
+// o 90 stupňů tak, že jsme ho vynásobili maticí `m5`. Matice o rozměrech
+// 3x3 prvky je sice nejznámější reprezentací rotace, ovšem není jedinou.
+// Knihovna **Gonum** obsahuje balíček **spatial/r3** s vektory a maticemi
+// určenými pro trojrozměrný prostor a typem `Rotation`, který rotaci
+// reprezentuje kvaternionem. S kvaterniony (tedy zobecněním komplexních
+// čísel se třemi imaginárními jednotkami `i`, `j` a `k`) lze pracovat
+// i přímo pomocí balíčku **num/quat**. V této části si obě reprezentace
+// porovnáme, ukážeme si skládání rotací, převody mezi maticí a
+// kvaternionem a také hromadění zaokrouhlovacích chyb při opakovaných
+// rotacích.
+
+/*
+Copyright © 2020 Pavel Tisnovsky
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+// Kromě balíčků **fmt**, **math** a **mat** použijeme balíčky **r3** a
+// **quat**:
+
+import (
+	"fmt"
+	"math"
+
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/num/quat"
+	"gonum.org/v1/gonum/spatial/r3"
+)
+
+// Převod rotace na matici zajišťuje metoda `Rotation.Mat`, opačný převod
+// však v balíčku **r3** nenalezneme. Napíšeme si ho tedy sami. Reálná
+// složka kvaternionu je určena stopou matice (součtem prvků na hlavní
+// diagonále), imaginární složky rozdíly prvků symetrických podle hlavní
+// diagonály. Pokud je stopa záporná, dělili bychom malým číslem, proto se
+// v takovém případě výpočet odvodí od největšího prvku na diagonále:
+func rotationFromMatrix(m mat.Matrix) r3.Rotation {
+	m00, m01, m02 := m.At(0, 0), m.At(0, 1), m.At(0, 2)
+	m10, m11, m12 := m.At(1, 0), m.At(1, 1), m.At(1, 2)
+	m20, m21, m22 := m.At(2, 0), m.At(2, 1), m.At(2, 2)
+	var q quat.Number
+	switch trace := m00 + m11 + m22; {
+	case trace > 0:
+		s := 2 * math.Sqrt(1+trace)
+		q = quat.Number{Real: s / 4, Imag: (m21 - m12) / s, Jmag: (m02 - m20) / s, Kmag: (m10 - m01) / s}
+	case m00 > m11 && m00 > m22:
+		s := 2 * math.Sqrt(1+m00-m11-m22)
+		q = quat.Number{Real: (m21 - m12) / s, Imag: s / 4, Jmag: (m01 + m10) / s, Kmag: (m02 + m20) / s}
+	case m11 > m22:
+		s := 2 * math.Sqrt(1+m11-m00-m22)
+		q = quat.Number{Real: (m02 - m20) / s, Imag: (m01 + m10) / s, Jmag: s / 4, Kmag: (m12 + m21) / s}
+	default:
+		s := 2 * math.Sqrt(1+m22-m00-m11)
+		q = quat.Number{Real: (m10 - m01) / s, Imag: (m02 + m20) / s, Jmag: (m12 + m21) / s, Kmag: s / 4}
+	}
+	return r3.Rotation(q)
+}
+
+// Všechny další příkazy opět umístíme do funkce **main**:
+func main() {
+	// ## Rotace maticí
+
+	// Nejdříve zopakujeme příklad z části o součinu matice a vektoru.
+	// Vektor `[2, 3, 4]` otočíme maticí `m5` okolo osy `z` o 90 stupňů:
+	m5 := mat.NewDense(3, 3, []float64{0, -1, 0, 1, 0, 0, 0, 0, 1})
+	v5 := mat.NewVecDense(3, []float64{2, 3, 4})
+	v5.MulVec(m5, v5)
+	fmt.Println(mat.Formatted(v5))
+
+	// Výsledek je stejný jako minule:
+
+	//     ⎡-3⎤
+	//     ⎢ 2⎥
+	//     ⎣ 4⎦
+
+	// ## Vektory a rotace z balíčku r3
+
+	// Vektor v balíčku **r3** je jednoduchá struktura se třemi prvky `X`,
+	// `Y` a `Z`, se kterou se pracuje jako s hodnotou - není tedy nutné
+	// alokovat paměť pro výsledek. Rotaci vytvoříme konstruktorem
+	// `NewRotation`, kterému předáme úhel v radiánech a osu otáčení:
+	p := r3.Vec{X: 2, Y: 3, Z: 4}
+	rz := r3.NewRotation(math.Pi/2, r3.Vec{Z: 1})
+	fmt.Println(rz.Rotate(p))
+
+	// Hodnota úhlu `π/2` není v počítači reprezentována přesně, proto
+	// výsledek obsahuje malou zaokrouhlovací chybu:
+
+	//     {-3 2.000000000000001 4}
+
+	// Rotace je reprezentována jednotkovým kvaternionem. Rotaci o úhel `α`
+	// okolo osy `u` odpovídá kvaternion `cos(α/2) + sin(α/2) (uₓi + uᵧj +
+	// u_z k)`:
+	fmt.Printf("%.6f\n", quat.Number(rz))
+
+	// Výsledek:
+
+	//     (0.707107+0.000000i+0.000000j+0.707107k)
+
+	// Metoda `Mat` převede rotaci na matici typu `r3.Mat`. Ten implementuje
+	// rozhraní `mat.Matrix`, takže matici můžeme vypsat funkcí
+	// `mat.Formatted` a porovnat s maticí `m5`:
+	fmt.Printf("%.3f\n", mat.Formatted(rz.Mat()))
+	fmt.Println(mat.EqualApprox(rz.Mat(), m5, 1e-12))
+
+	// Výsledek:
+
+	//     ⎡ 0.000  -1.000   0.000⎤
+	//     ⎢ 1.000   0.000   0.000⎥
+	//     ⎣ 0.000   0.000   1.000⎦
+	//     true
+
+	// ## Skládání rotací
+
+	// Druhou rotací bude otočení okolo osy `x` o 90 stupňů. Pokud nejdříve
+	// provedeme rotaci `rz` a poté rotaci `rx`, odpovídá výsledná rotace
+	// součinu matic `Rx Rz` - matice rotace provedené jako první je v
+	// součinu vpravo, protože se vektorem násobí jako první:
+	rx := r3.NewRotation(math.Pi/2, r3.Vec{X: 1})
+	var composed mat.Dense
+	composed.Mul(rx.Mat(), rz.Mat())
+	fmt.Printf("%.3f\n", mat.Formatted(&composed))
+	fmt.Printf("%.3f\n", rx.Mat().MulVec(rz.Mat().MulVec(p)))
+
+	// Výsledek:
+
+	//     ⎡ 0.000  -1.000   0.000⎤
+	//     ⎢ 0.000   0.000  -1.000⎥
+	//     ⎣ 1.000   0.000   0.000⎦
+	//     {-3.000 -4.000 2.000}
+
+	// Rotace reprezentované kvaterniony se skládají stejně, tedy
+	// násobením ve stejném pořadí. Součin kvaternionů vypočte funkce
+	// `quat.Mul`:
+	rxz := r3.Rotation(quat.Mul(quat.Number(rx), quat.Number(rz)))
+	fmt.Printf("%.3f\n", rxz.Rotate(p))
+
+	// Vektor je otočen stejně jako při použití matic:
+
+	//     {-3.000 -4.000 2.000}
+
+	// Skládání rotací (na rozdíl od skládání posunů) není komutativní.
+	// Pokud rotace provedeme v opačném pořadí, dostaneme jiný výsledek:
+	rzx := r3.Rotation(quat.Mul(quat.Number(rz), quat.Number(rx)))
+	fmt.Printf("%.3f\n", rzx.Rotate(p))
+
+	// Výsledek:
+
+	//     {4.000 2.000 3.000}
+
+	// ## Převod matice na kvaternion
+
+	// Složenou matici převedeme zpět na kvaternion funkcí
+	// `rotationFromMatrix`, kterou jsme si napsali na začátku. Výsledek
+	// porovnáme s kvaternionem vypočteným součinem kvaternionů:
+	fmt.Printf("%.6f\n", quat.Number(rotationFromMatrix(&composed)))
+	fmt.Printf("%.6f\n", quat.Number(rxz))
+
+	// Oba kvaterniony jsou shodné. Obecně by se mohly lišit znaménkem,
+	// protože kvaterniony `q` a `-q` představují tutéž rotaci:
+
+	//     (0.500000+0.500000i-0.500000j+0.500000k)
+	//     (0.500000+0.500000i-0.500000j+0.500000k)
+
+	// Převod tam a zpět lze ověřit i pro obecnější rotaci, například
+	// o 1 radián okolo osy `[1, 2, 3]`:
+	r := r3.NewRotation(1, r3.Vec{X: 1, Y: 2, Z: 3})
+	back := rotationFromMatrix(r.Mat())
+	fmt.Println(quat.Abs(quat.Sub(quat.Number(back), quat.Number(r))) < 1e-15)
+
+	// Výsledek:
+
+	//     true
+
+	// ## Hromadění zaokrouhlovacích chyb
+
+	// Každá rotace by měla zachovat délku vektoru. Prvky matice rotace
+	// však nejsou reprezentovány přesně, takže se délka vektoru při každém
+	// vynásobení maticí nepatrně změní. Otočíme vektor milionkrát o tisícinu
+	// plné otáčky okolo osy `[1, 1, 1]`, tedy celkem o tisíc otáček.
+	// Současně budeme skládat i samotné rotace - jednou násobením matic,
+	// podruhé násobením kvaternionů:
+	step := r3.NewRotation(2*math.Pi/1000, r3.Vec{X: 1, Y: 1, Z: 1})
+	stepMatrix := step.Mat()
+	v := mat.NewVecDense(3, []float64{2, 3, 4})
+	length := mat.Norm(v, 2)
+	accumulated := mat.NewDense(3, 3, []float64{1, 0, 0, 0, 1, 0, 0, 0, 1})
+	q := quat.Number{Real: 1}
+	fmt.Println("   steps  |v| error  |MᵀM-I|   |q| error")
+	for i := 1; i <= 1000000; i++ {
+		v.MulVec(stepMatrix, v)
+		accumulated.Mul(stepMatrix, accumulated)
+		q = quat.Mul(quat.Number(step), q)
+		if i%250000 == 0 {
+			// matice rotace je ortogonální, tedy MᵀM = I
+			var orthogonality mat.Dense
+			orthogonality.Mul(accumulated.T(), accumulated)
+			orthogonality.Sub(&orthogonality, mat.NewDiagDense(3, []float64{1, 1, 1}))
+			fmt.Printf("%8d %10.1e %10.1e %10.1e\n", i,
+				mat.Norm(v, 2)-length, mat.Norm(&orthogonality, math.Inf(1)), quat.Abs(q)-1)
+		}
+	}
+	fmt.Printf("%.12f\n", v.RawVector().Data)
+
+	// Chyby rostou lineárně s počtem kroků. Vektor se postupně zkracuje,
+	// složená matice přestává být ortogonální a kvaternion přestává být
+	// jednotkový. Po tisíci otáčkách by vektor měl mít původní hodnotu
+	// `[2, 3, 4]`, ovšem jeho prvky se od původních hodnot liší řádově
+	// o `1e-10`:
+
+	//        steps  |v| error  |MᵀM-I|   |q| error
+	//       250000   -6.3e-11    2.4e-11   -4.4e-11
+	//       500000   -1.3e-10    4.7e-11   -8.8e-11
+	//       750000   -1.9e-10    7.0e-11   -1.3e-10
+	//      1000000   -2.5e-10    9.4e-11   -1.8e-10
+	//     [1.999999999906 2.999999999862 3.999999999812]
+
+	// Odchylku kvaternionu od jednotkové délky lze snadno odstranit jeho
+	// vydělením vlastní absolutní hodnotou. Obnovení ortogonality matice
+	// je mnohem složitější (například pomocí QR rozkladu), což je jeden
+	// z důvodů, proč se v počítačové grafice a robotice pro skládání rotací
+	// používají kvaterniony:
+	q = quat.Scale(1/quat.Abs(q), q)
+	fmt.Printf("%.1e\n", quat.Abs(q)-1)
+
+	// Výsledek:
+
+	//     0.0e+00
+
+	// # finito █
+}
@@ -0,0 +1,381 @@
+//go:build example
+
+// # Knihovna Gonum: zobrazení struktury řídkých matic
+
+// ## Úvodní informace
+
+// V základním textu o knihovně **Gonum** jsme si ukázali, že tisk velké
+// matice o rozměrech 100x100 prvků není přehledný ani při použití funkce
+// `mat.Formatted` s volbou `mat.Excerpt(5)` - vidíme sice mezní řádky a
+// sloupce, ovšem o celkové struktuře matice se nedozvíme prakticky nic.
+// Například v **Matlabu** nebo v knihovně **Matplotlib** se pro tento účel
+// používá takzvaný *spy* graf, v němž je každý nenulový prvek matice
+// zobrazen jako bod. Na první pohled je tak patrné, zda se jedná o matici
+// diagonální, pásovou, trojúhelníkovou atd.
+
+// Knihovna **Gonum** sice podobnou funkci neobsahuje, ovšem není příliš
+// složité si ji naprogramovat. Výsledek přitom zobrazíme jak přímo v
+// terminálu (s využitím znaků Braillova písma a blokových znaků z Unicode),
+// tak i ve formě vektorového obrázku ve formátu SVG, který lze vložit do
+// dokumentace.
+
+/*
+Copyright © 2020 Pavel Tisnovsky
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+// Kromě balíčků **fmt** a **mat** budeme potřebovat i balíčky pro práci s
+// řetězci a se soubory (SVG obrázky se ukládají na disk):
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+// ## Mřížka se vzorkem nenulových prvků
+
+// Všechny tři způsoby zobrazení sdílí stejný základ - mřížku logických
+// hodnot, v níž `true` znamená, že v odpovídající oblasti matice leží
+// alespoň jeden nenulový prvek. Pokud je matice menší než požadovaná
+// mřížka, odpovídá jeden bod mřížky jednomu prvku matice. U větších matic
+// je nutné provést podvzorkování (*downsampling*) - jeden bod mřížky pak
+// pokrývá celý blok prvků matice.
+func spyGrid(m mat.Matrix, height, width int) [][]bool {
+	rows, cols := m.Dims()
+	if height > rows {
+		height = rows
+	}
+	if width > cols {
+		width = cols
+	}
+
+	// Při nulové (nebo dokonce záporné) šířce či výšce je mřížka prázdná
+	if height <= 0 || width <= 0 {
+		return nil
+	}
+
+	grid := make([][]bool, height)
+	for i := range grid {
+		grid[i] = make([]bool, width)
+	}
+
+	// Každý prvek matice je namapován do právě jednoho bodu mřížky
+	for r := 0; r < rows; r++ {
+		for c := 0; c < cols; c++ {
+			if m.At(r, c) != 0 {
+				grid[r*height/rows][c*width/cols] = true
+			}
+		}
+	}
+	return grid
+}
+
+// Počet nenulových prvků (*nnz*, *number of non-zeros*) vypíšeme v
+// hlavičce každého zobrazení, podobně jako `mat.Formatted` vypisuje
+// rozměry matice
+func nonZeros(m mat.Matrix) int {
+	rows, cols := m.Dims()
+	nnz := 0
+	for r := 0; r < rows; r++ {
+		for c := 0; c < cols; c++ {
+			if m.At(r, c) != 0 {
+				nnz++
+			}
+		}
+	}
+	return nnz
+}
+
+// ## Zobrazení s využitím Braillova písma
+
+// Znaky Braillova písma jsou v Unicode uloženy od kódu `U+2800` a každý
+// znak obsahuje mřížku 2x4 bodů. Každému bodu odpovídá jeden bit v kódu
+// znaku, takže jediným znakem lze zobrazit osm prvků (resp. bloků) matice.
+// Parametry `maxWidth` a `maxHeight` určují maximální počet znaků na
+// šířku a na výšku.
+var brailleDots = [4][2]rune{
+	{0x01, 0x08},
+	{0x02, 0x10},
+	{0x04, 0x20},
+	{0x40, 0x80},
+}
+
+func spyBraille(m mat.Matrix, maxWidth, maxHeight int) string {
+	rows, cols := m.Dims()
+	grid := spyGrid(m, 4*maxHeight, 2*maxWidth)
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "Dims(%d, %d), nnz=%d\n", rows, cols, nonZeros(m))
+
+	// Prázdnou mřížku nelze zobrazit, vypíše se tedy jen hlavička
+	if len(grid) == 0 {
+		return sb.String()
+	}
+	height := len(grid)
+	width := len(grid[0])
+	for y := 0; y < height; y += 4 {
+		sb.WriteRune('⎢')
+		for x := 0; x < width; x += 2 {
+			ch := rune(0x2800)
+			for dy := 0; dy < 4 && y+dy < height; dy++ {
+				for dx := 0; dx < 2 && x+dx < width; dx++ {
+					if grid[y+dy][x+dx] {
+						ch |= brailleDots[dy][dx]
+					}
+				}
+			}
+			sb.WriteRune(ch)
+		}
+		sb.WriteString("⎥\n")
+	}
+	return sb.String()
+}
+
+// ## Zobrazení s využitím blokových znaků
+
+// Ne všechny terminálové fonty obsahují znaky Braillova písma. Bezpečnější
+// (i když méně podrobnou) alternativou jsou znaky `▀`, `▄` a `█`, které
+// zobrazí dva body nad sebou.
+func spyBlocks(m mat.Matrix, maxWidth, maxHeight int) string {
+	rows, cols := m.Dims()
+	grid := spyGrid(m, 2*maxHeight, maxWidth)
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "Dims(%d, %d), nnz=%d\n", rows, cols, nonZeros(m))
+	if len(grid) == 0 {
+		return sb.String()
+	}
+	height := len(grid)
+	width := len(grid[0])
+	for y := 0; y < height; y += 2 {
+		sb.WriteRune('⎢')
+		for x := 0; x < width; x++ {
+			upper := grid[y][x]
+			lower := y+1 < height && grid[y+1][x]
+			switch {
+			case upper && lower:
+				sb.WriteRune('█')
+			case upper:
+				sb.WriteRune('▀')
+			case lower:
+				sb.WriteRune('▄')
+			default:
+				sb.WriteRune(' ')
+			}
+		}
+		sb.WriteString("⎥\n")
+	}
+	return sb.String()
+}
+
+// ## Export do formátu SVG
+
+// Pro dokumentaci je výhodnější vektorový obrázek. Každý bod mřížky s
+// nenulovými prvky je v něm reprezentován čtverečkem (obdélníkem) o
+// velikosti odpovídající podvzorkovanému bloku matice. Parametr `size`
+// určuje velikost obrázku v pixelech, `maxCells` maximální počet bodů
+// mřížky na šířku i na výšku.
+func spySVG(m mat.Matrix, size, maxCells int) string {
+	rows, cols := m.Dims()
+	grid := spyGrid(m, maxCells, maxCells)
+
+	// Poměr stran obrázku odpovídá poměru stran matice
+	w := float64(size)
+	h := float64(size)
+	if rows > cols {
+		w = w * float64(cols) / float64(rows)
+	} else {
+		h = h * float64(rows) / float64(cols)
+	}
+
+	// Prázdná mřížka nemá žádné body, obrázek pak obsahuje jen rámeček
+	cw, ch := 0.0, 0.0
+	if len(grid) > 0 {
+		cw = w / float64(len(grid[0]))
+		ch = h / float64(len(grid))
+	}
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, `<svg xmlns="http://www.w3.org/2000/svg" width="%.0f" height="%.0f" viewBox="0 0 %.2f %.2f">`+"\n", w, h, w, h)
+	fmt.Fprintf(&sb, "<title>Dims(%d, %d), nnz=%d</title>\n", rows, cols, nonZeros(m))
+	fmt.Fprintf(&sb, `<rect width="%.2f" height="%.2f" fill="white" stroke="black"/>`+"\n", w, h)
+	for y := range grid {
+		for x := range grid[y] {
+			if grid[y][x] {
+				fmt.Fprintf(&sb, `<rect x="%.2f" y="%.2f" width="%.2f" height="%.2f" fill="navy"/>`+"\n",
+					float64(x)*cw, float64(y)*ch, cw, ch)
+			}
+		}
+	}
+	sb.WriteString("</svg>\n")
+	return sb.String()
+}
+
+// Obrázky budeme ukládat do adresáře s vygenerovanou dokumentací, odkud
+// jsou přímo dostupné relativní cestou. Nástroj `weave` předává jméno
+// tohoto adresáře v proměnné prostředí `LITERATE_OUTPUT`, při samostatném
+// spuštění příkladu se použije adresář `docs`.
+func outputFile(name string) string {
+	dir := os.Getenv("LITERATE_OUTPUT")
+	if dir == "" {
+		dir = "docs"
+	}
+	return filepath.Join(dir, name)
+}
+
+// Obrázek se *spy* grafem pak již jen zapíšeme do souboru:
+func writeSpySVG(m mat.Matrix, name string) {
+	err := os.WriteFile(outputFile(name), []byte(spySVG(m, 200, 100)), 0644)
+	if err != nil {
+		fmt.Println(err)
+	}
+}
+
+// Všechny další příkazy opět umístíme do funkce **main**:
+func main() {
+	// ## Diagonální matice
+
+	// Začneme stejnou maticí, jako v základním textu - jednotkovou maticí o
+	// rozměrech 100x100 prvků:
+	big := mat.NewDense(100, 100, nil)
+	for i := 0; i < 100; i++ {
+		big.Set(i, i, 1)
+	}
+
+	// Matici zobrazíme s využitím Braillova písma na ploše 20x10 znaků. Na
+	// jeden znak tedy připadá blok deseti řádků a pěti sloupců matice (ve čtyřech řádcích
+	// a dvou sloupcích bodů):
+	fmt.Println(spyBraille(big, 20, 10))
+
+	// Hlavní diagonála je nyní na první pohled patrná:
+
+	//     Dims(100, 100), nnz=100
+	//     ⎢⠑⢄⠀⠀⠀⠀⠀⠀⠀⠀⠀⠀⠀⠀⠀⠀⠀⠀⠀⠀⎥
+	//     ⎢⠀⠀⠑⢄⠀⠀⠀⠀⠀⠀⠀⠀⠀⠀⠀⠀⠀⠀⠀⠀⎥
+	//     ⎢⠀⠀⠀⠀⠑⢄⠀⠀⠀⠀⠀⠀⠀⠀⠀⠀⠀⠀⠀⠀⎥
+	//     ⎢⠀⠀⠀⠀⠀⠀⠑⢄⠀⠀⠀⠀⠀⠀⠀⠀⠀⠀⠀⠀⎥
+	//     ⎢⠀⠀⠀⠀⠀⠀⠀⠀⠑⢄⠀⠀⠀⠀⠀⠀⠀⠀⠀⠀⎥
+	//     ⎢⠀⠀⠀⠀⠀⠀⠀⠀⠀⠀⠑⢄⠀⠀⠀⠀⠀⠀⠀⠀⎥
+	//     ⎢⠀⠀⠀⠀⠀⠀⠀⠀⠀⠀⠀⠀⠑⢄⠀⠀⠀⠀⠀⠀⎥
+	//     ⎢⠀⠀⠀⠀⠀⠀⠀⠀⠀⠀⠀⠀⠀⠀⠑⢄⠀⠀⠀⠀⎥
+	//     ⎢⠀⠀⠀⠀⠀⠀⠀⠀⠀⠀⠀⠀⠀⠀⠀⠀⠑⢄⠀⠀⎥
+	//     ⎢⠀⠀⠀⠀⠀⠀⠀⠀⠀⠀⠀⠀⠀⠀⠀⠀⠀⠀⠑⢄⎥
+
+	// Stejnou matici si uložíme i ve formátu SVG:
+	writeSpySVG(big, "spy_big.svg")
+
+	// ![Jednotková matice 100x100](spy_big.svg)
+
+	// ## Pásová matice
+
+	// Pásová (*band*) matice obsahuje nenulové prvky pouze na hlavní
+	// diagonále a v jejím blízkém okolí. Knihovna **Gonum** pro tyto
+	// matice nabízí speciální typ `BandDense`, v němž se ukládají pouze
+	// prvky pásu. Vytvoříme si matici s jednou diagonálou pod hlavní
+	// diagonálou a dvěma diagonálami nad ní:
+	band := mat.NewBandDense(60, 60, 1, 2, nil)
+	for i := 0; i < 60; i++ {
+		for j := i - 1; j <= i+2; j++ {
+			if j >= 0 && j < 60 {
+				band.SetBand(i, j, 1)
+			}
+		}
+	}
+
+	// Pro změnu použijeme blokové znaky:
+	fmt.Println(spyBlocks(band, 30, 15))
+
+	// Výsledkem je "tlustší" diagonála:
+
+	//     Dims(60, 60), nnz=236
+	//     ⎢██▄                           ⎥
+	//     ⎢ ▀██▄                         ⎥
+	//     ⎢   ▀██▄                       ⎥
+	//     ⎢     ▀██▄                     ⎥
+	//     ⎢       ▀██▄                   ⎥
+	//     ⎢         ▀██▄                 ⎥
+	//     ⎢           ▀██▄               ⎥
+	//     ⎢             ▀██▄             ⎥
+	//     ⎢               ▀██▄           ⎥
+	//     ⎢                 ▀██▄         ⎥
+	//     ⎢                   ▀██▄       ⎥
+	//     ⎢                     ▀██▄     ⎥
+	//     ⎢                       ▀██▄   ⎥
+	//     ⎢                         ▀██▄ ⎥
+	//     ⎢                           ▀██⎥
+
+	writeSpySVG(band, "spy_band.svg")
+
+	// ![Pásová matice 60x60](spy_band.svg)
+
+	// ## Trojúhelníková matice
+
+	// Podobně snadno rozpoznáme i trojúhelníkovou matici. Dolní
+	// trojúhelníkovou matici 40x40 naplníme jedničkami:
+	tri := mat.NewTriDense(40, mat.Lower, nil)
+	for i := 0; i < 40; i++ {
+		for j := 0; j <= i; j++ {
+			tri.SetTri(i, j, 1)
+		}
+	}
+
+	fmt.Println(spyBraille(tri, 20, 10))
+
+	// Výsledek:
+
+	//     Dims(40, 40), nnz=820
+	//     ⎢⣷⣄⠀⠀⠀⠀⠀⠀⠀⠀⠀⠀⠀⠀⠀⠀⠀⠀⠀⠀⎥
+	//     ⎢⣿⣿⣷⣄⠀⠀⠀⠀⠀⠀⠀⠀⠀⠀⠀⠀⠀⠀⠀⠀⎥
+	//     ⎢⣿⣿⣿⣿⣷⣄⠀⠀⠀⠀⠀⠀⠀⠀⠀⠀⠀⠀⠀⠀⎥
+	//     ⎢⣿⣿⣿⣿⣿⣿⣷⣄⠀⠀⠀⠀⠀⠀⠀⠀⠀⠀⠀⠀⎥
+	//     ⎢⣿⣿⣿⣿⣿⣿⣿⣿⣷⣄⠀⠀⠀⠀⠀⠀⠀⠀⠀⠀⎥
+	//     ⎢⣿⣿⣿⣿⣿⣿⣿⣿⣿⣿⣷⣄⠀⠀⠀⠀⠀⠀⠀⠀⎥
+	//     ⎢⣿⣿⣿⣿⣿⣿⣿⣿⣿⣿⣿⣿⣷⣄⠀⠀⠀⠀⠀⠀⎥
+	//     ⎢⣿⣿⣿⣿⣿⣿⣿⣿⣿⣿⣿⣿⣿⣿⣷⣄⠀⠀⠀⠀⎥
+	//     ⎢⣿⣿⣿⣿⣿⣿⣿⣿⣿⣿⣿⣿⣿⣿⣿⣿⣷⣄⠀⠀⎥
+	//     ⎢⣿⣿⣿⣿⣿⣿⣿⣿⣿⣿⣿⣿⣿⣿⣿⣿⣿⣿⣷⣄⎥
+
+	writeSpySVG(tri, "spy_tri.svg")
+
+	// ![Dolní trojúhelníková matice 40x40](spy_tri.svg)
+
+	// ## Malé matice
+
+	// Pokud je matice menší než plocha určená pro zobrazení, podvzorkování
+	// se neprovádí a každému prvku matice odpovídá jeden bod. Zkusme si
+	// zobrazit matici 3x4 z úvodní části, ve které vynulujeme jeden řádek:
+	small := mat.NewDense(3, 4, []float64{1, 2, 3, 4, 0, 0, 0, 0, 9, 10, 11, 12})
+	fmt.Println(spyBlocks(small, 30, 15))
+
+	// Výsledek (první řádek tvoří horní polovinu znaků, druhý řádek dolní
+	// polovinu, třetí řádek opět horní polovinu dalšího řádku znaků):
+
+	//     Dims(3, 4), nnz=8
+	//     ⎢▀▀▀▀⎥
+	//     ⎢▀▀▀▀⎥
+
+	// Při nulové šířce nebo výšce zobrazení není co vykreslit, vypíše se
+	// tedy pouze hlavička:
+	fmt.Println(spyBraille(small, 0, 10))
+
+	//     Dims(3, 4), nnz=8
+
+	// # finito █
+}
@@ -0,0 +1,261 @@
+/*
+Copyright © 2020 Pavel Tisnovsky
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package instrument rewrites literate Go source so that every value
+// printed by fmt.Print, fmt.Println or fmt.Printf is also emitted as a JSON
+// record (see package dump). Calls of fmt.Fprint, fmt.Fprintln and
+// fmt.Fprintf are rewritten only when they write to os.Stdout; other
+// writers (for example strings.Builder used to prepare text) do not
+// produce output of the program, so their values are not recorded.
+// Section names are taken from the nearest preceding
+// "// #" heading. The rewritten source has the same lines as the original
+// one, so line numbers in records refer to the original file.
+package instrument
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// DumpPackage is import path of the package with recording functions.
+const DumpPackage = "github.com/tisnik/literate-programming-examples/dump"
+
+// heading represents one Markdown heading found in comments.
+type heading struct {
+	line  int
+	title string
+}
+
+// instrumenter holds state needed to rewrite one source file.
+type instrumenter struct {
+	fset     *token.FileSet
+	src      []byte
+	filename string
+	headings []heading
+	edits    []edit
+	rewrites int
+}
+
+// collectHeadings finds all "// #" comments and remembers their lines.
+func (in *instrumenter) collectHeadings(file *ast.File) {
+	for _, group := range file.Comments {
+		for _, comment := range group.List {
+			text := strings.TrimPrefix(comment.Text, "//")
+			text = strings.TrimSpace(text)
+			if !strings.HasPrefix(text, "#") {
+				continue
+			}
+			title := strings.TrimSpace(strings.TrimLeft(text, "#"))
+			line := in.fset.Position(comment.Pos()).Line
+			in.headings = append(in.headings, heading{line, title})
+		}
+	}
+	sort.Slice(in.headings, func(i, j int) bool {
+		return in.headings[i].line < in.headings[j].line
+	})
+}
+
+// section returns title of the nearest heading before given line.
+func (in *instrumenter) section(line int) string {
+	title := ""
+	for _, h := range in.headings {
+		if h.line > line {
+			break
+		}
+		title = h.title
+	}
+	return title
+}
+
+// source returns original source code of given node.
+func (in *instrumenter) source(node ast.Node) string {
+	start := in.fset.Position(node.Pos()).Offset
+	end := in.fset.Position(node.End()).Offset
+	return string(in.src[start:end])
+}
+
+// isSelector checks if expression is in form pkg.Name.
+func isSelector(expr ast.Expr, pkg, name string) bool {
+	sel, ok := expr.(*ast.SelectorExpr)
+	if !ok {
+		return false
+	}
+	ident, ok := sel.X.(*ast.Ident)
+	return ok && ident.Name == pkg && sel.Sel.Name == name
+}
+
+// edit replaces part of the original source with new text.
+type edit struct {
+	start, end int
+	text       string
+}
+
+// replace schedules replacement of given node by new text.
+func (in *instrumenter) replace(node ast.Node, text string) {
+	start := in.fset.Position(node.Pos()).Offset
+	end := in.fset.Position(node.End()).Offset
+	in.edits = append(in.edits, edit{start, end, text})
+}
+
+// printFunctions maps names of rewritten fmt functions to number of
+// arguments preceding the printed values (writer and format string).
+var printFunctions = map[string]int{
+	"Print":    0,
+	"Println":  0,
+	"Printf":   1,
+	"Fprint":   1,
+	"Fprintln": 1,
+	"Fprintf":  2,
+}
+
+// rewriteCall replaces fmt.Println(args) by
+// dump.At(location, exprs).Println(args) and similarly for other print
+// functions. The replacement never contains new lines, so line numbers
+// reported by the compiler and at runtime still refer to the original
+// file.
+func (in *instrumenter) rewriteCall(call *ast.CallExpr) {
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok || !isSelector(call.Fun, "fmt", sel.Sel.Name) {
+		return
+	}
+	method := sel.Sel.Name
+	skip, ok := printFunctions[method]
+	if !ok || len(call.Args) < skip {
+		return
+	}
+	if strings.HasPrefix(method, "F") && !isSelector(call.Args[0], "os", "Stdout") {
+		return
+	}
+	values := call.Args[skip:]
+
+	line := in.fset.Position(call.Pos()).Line
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "dump.At(dump.Location{Section: %s, File: %s, Line: %d}",
+		strconv.Quote(in.section(line)), strconv.Quote(filepath.Base(in.filename)), line)
+	for _, value := range values {
+		sb.WriteString(", ")
+		sb.WriteString(strconv.Quote(in.source(value)))
+	}
+	sb.WriteString(").")
+	sb.WriteString(method)
+	in.replace(call.Fun, sb.String())
+	in.rewrites++
+
+	// mat.Formatted hides the matrix, so it is replaced by a wrapper
+	// that keeps the matrix available for recording
+	for _, value := range values {
+		if inner, ok := value.(*ast.CallExpr); ok && isSelector(inner.Fun, "mat", "Formatted") {
+			in.replace(inner.Fun, "dump.Formatted")
+		}
+	}
+}
+
+// usesPackage checks if there is a reference to given package that is not
+// going to be rewritten.
+func (in *instrumenter) usesPackage(file *ast.File, pkg string) bool {
+	rewritten := map[int]bool{}
+	for _, e := range in.edits {
+		rewritten[e.start] = true
+	}
+	used := false
+	ast.Inspect(file, func(node ast.Node) bool {
+		if sel, ok := node.(*ast.SelectorExpr); ok {
+			ident, ok := sel.X.(*ast.Ident)
+			offset := in.fset.Position(sel.Pos()).Offset
+			if ok && ident.Name == pkg && !rewritten[offset] {
+				used = true
+			}
+		}
+		return !used
+	})
+	return used
+}
+
+// fixImports adds import declaration of dump package after the last
+// import declaration and blanks the "fmt" import when all its calls have
+// been rewritten. The new declaration is written on the same line, so both
+// grouped and single imports are followed by valid code.
+func (in *instrumenter) fixImports(file *ast.File) error {
+	var last *ast.GenDecl
+	for _, decl := range file.Decls {
+		if gen, ok := decl.(*ast.GenDecl); ok && gen.Tok == token.IMPORT {
+			last = gen
+		}
+	}
+	if last == nil {
+		return fmt.Errorf("%s: no import declaration found", in.filename)
+	}
+	end := in.fset.Position(last.End()).Offset
+	in.edits = append(in.edits, edit{end, end, "; import " + strconv.Quote(DumpPackage)})
+
+	if in.usesPackage(file, "fmt") {
+		return nil
+	}
+	for _, spec := range file.Imports {
+		if spec.Path.Value == strconv.Quote("fmt") && spec.Name == nil {
+			start := in.fset.Position(spec.Pos()).Offset
+			in.edits = append(in.edits, edit{start, start, "_ "})
+		}
+	}
+	return nil
+}
+
+// apply applies all scheduled edits to the original source.
+func (in *instrumenter) apply() []byte {
+	sort.SliceStable(in.edits, func(i, j int) bool {
+		return in.edits[i].start < in.edits[j].start
+	})
+	var out bytes.Buffer
+	offset := 0
+	for _, e := range in.edits {
+		out.Write(in.src[offset:e.start])
+		out.WriteString(e.text)
+		offset = e.end
+	}
+	out.Write(in.src[offset:])
+	return out.Bytes()
+}
+
+// Rewrite rewrites given source file and returns the new source together
+// with number of rewritten print statements.
+func Rewrite(filename string, src []byte) ([]byte, int, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, filename, src, parser.ParseComments)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	in := &instrumenter{fset: fset, src: src, filename: filename}
+	in.collectHeadings(file)
+
+	ast.Inspect(file, func(node ast.Node) bool {
+		if call, ok := node.(*ast.CallExpr); ok {
+			in.rewriteCall(call)
+		}
+		return true
+	})
+	if err := in.fixImports(file); err != nil {
+		return nil, 0, err
+	}
+	return in.apply(), in.rewrites, nil
+}
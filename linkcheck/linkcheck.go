@@ -0,0 +1,201 @@
+/*
+Copyright © 2020 Pavel Tisnovsky
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package linkcheck checks targets of links found in literate sources. The
+// targets can be requested directly, through a local server that stands in
+// for the real hosts, or looked up in a fixture with cached responses, so
+// the check can be repeated without network access.
+package linkcheck
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+)
+
+// Status is result of request for one URL.
+type Status struct {
+	Code     int    `json:"status,omitempty"`
+	Location string `json:"location,omitempty"`
+	Error    string `json:"error,omitempty"`
+}
+
+// Dead checks whether target of link is not available.
+func (s Status) Dead() bool {
+	return s.Error != "" || s.Code >= 400
+}
+
+// Redirected checks whether target of link has been moved.
+func (s Status) Redirected() bool {
+	return s.Code >= 300 && s.Code < 400
+}
+
+// String returns textual representation of status.
+func (s Status) String() string {
+	switch {
+	case s.Error != "":
+		return s.Error
+	case s.Redirected() && s.Location != "":
+		return fmt.Sprintf("%d %s, redirected to %s", s.Code, http.StatusText(s.Code), s.Location)
+	default:
+		return fmt.Sprintf("%d %s", s.Code, http.StatusText(s.Code))
+	}
+}
+
+// Fixture contains cached statuses indexed by URL.
+type Fixture map[string]Status
+
+// LoadFixture reads fixture from JSON file.
+func LoadFixture(filename string) (Fixture, error) {
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	fixture := Fixture{}
+	if err := json.Unmarshal(data, &fixture); err != nil {
+		return nil, fmt.Errorf("%s: %w", filename, err)
+	}
+	return fixture, nil
+}
+
+// Save writes fixture to JSON file, URLs are sorted.
+func (f Fixture) Save(filename string) error {
+	data, err := json.MarshalIndent(f, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filename, append(data, '\n'), 0644)
+}
+
+// Checker requests targets of links. Redirects are not followed, they are
+// reported instead.
+type Checker struct {
+	Client *http.Client
+	// Server, when set, receives all requests instead of the real hosts.
+	// Path and query are kept and the original host is sent in the Host
+	// header, so one server can stand in for all of them.
+	Server *url.URL
+	// Fixture contains cached statuses, URLs found there are not
+	// requested. Statuses of requested URLs are added to it.
+	Fixture Fixture
+	// Offline disables requests, URLs missing in fixture are reported
+	// as not cached.
+	Offline bool
+}
+
+// NewChecker constructs checker with given timeout of one request.
+func NewChecker(timeout time.Duration) *Checker {
+	return &Checker{
+		Client: &http.Client{
+			Timeout: timeout,
+			CheckRedirect: func(*http.Request, []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		},
+		Fixture: Fixture{},
+	}
+}
+
+// Checkable checks whether URL points to HTTP server, other links (for
+// example relative links to images) are not requested.
+func Checkable(rawURL string) bool {
+	return strings.HasPrefix(rawURL, "http://") || strings.HasPrefix(rawURL, "https://")
+}
+
+// Check returns status of given URL. Fragment is not part of the request,
+// so all links to one page share the cached status.
+func (c *Checker) Check(rawURL string) Status {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return Status{Error: err.Error()}
+	}
+	u.Fragment = ""
+	key := u.String()
+
+	if status, found := c.Fixture[key]; found {
+		return status
+	}
+	if c.Offline {
+		return Status{Error: "not found in fixture"}
+	}
+
+	// some servers do not implement HEAD properly
+	status := c.request(http.MethodHead, u)
+	if status.Code == http.StatusMethodNotAllowed || status.Code == http.StatusNotImplemented {
+		status = c.request(http.MethodGet, u)
+	}
+	c.Fixture[key] = status
+	return status
+}
+
+// request performs one request and converts response into status.
+func (c *Checker) request(method string, u *url.URL) Status {
+	target := *u
+	if c.Server != nil {
+		target.Scheme = c.Server.Scheme
+		target.Host = c.Server.Host
+	}
+	req, err := http.NewRequest(method, target.String(), nil)
+	if err != nil {
+		return Status{Error: err.Error()}
+	}
+	req.Host = u.Host
+	req.Header.Set("User-Agent", "checklinks")
+
+	resp, err := c.Client.Do(req)
+	if err != nil {
+		return Status{Error: err.Error()}
+	}
+	resp.Body.Close()
+
+	status := Status{Code: resp.StatusCode}
+	if location := resp.Header.Get("Location"); location != "" {
+		// relative location is resolved against the original URL
+		if l, err := u.Parse(location); err == nil {
+			location = l.String()
+		}
+		status.Location = location
+	}
+	return status
+}
+
+// Suggest returns replacement for URL pointing to service that has been
+// shut down or moved, or empty string. Documentation of Go packages moved
+// from godoc.org and golang.org/pkg to pkg.go.dev.
+func Suggest(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return ""
+	}
+	switch strings.TrimPrefix(u.Host, "www.") {
+	case "godoc.org":
+		// path is the import path of package
+	case "golang.org":
+		if !strings.HasPrefix(u.Path, "/pkg/") {
+			return ""
+		}
+		u.Path = strings.TrimPrefix(u.Path, "/pkg")
+	default:
+		return ""
+	}
+	u.Scheme = "https"
+	u.Host = "pkg.go.dev"
+	return u.String()
+}
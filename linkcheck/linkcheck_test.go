@@ -0,0 +1,158 @@
+/*
+Copyright © 2020 Pavel Tisnovsky
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package linkcheck
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"path/filepath"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+)
+
+// testServer stands in for several hosts, it records method and host of
+// all requests.
+func testServer(t *testing.T) (*Checker, *[]string) {
+	var mutex sync.Mutex
+	var requests []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mutex.Lock()
+		requests = append(requests, r.Method+" "+r.Host+r.URL.Path)
+		mutex.Unlock()
+
+		switch r.Host + r.URL.Path {
+		case "www.root.cz/clanky/":
+			w.WriteHeader(http.StatusOK)
+		case "godoc.org/gonum.org/v1/gonum/mat":
+			http.Redirect(w, r, "https://pkg.go.dev/gonum.org/v1/gonum/mat", http.StatusMovedPermanently)
+		case "numpy.org/old":
+			http.Redirect(w, r, "/new", http.StatusFound)
+		case "porter.io/github.com/go-gota/gota":
+			// server that does not implement HEAD
+			if r.Method == http.MethodHead {
+				w.WriteHeader(http.StatusMethodNotAllowed)
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	checker := NewChecker(5 * time.Second)
+	u, err := url.Parse(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	checker.Server = u
+	return checker, &requests
+}
+
+func TestCheck(t *testing.T) {
+	tests := []struct {
+		url      string
+		want     Status
+		requests []string
+	}{
+		{"https://www.root.cz/clanky/#k08", Status{Code: 200},
+			[]string{"HEAD www.root.cz/clanky/"}},
+		// redirect is reported, not followed
+		{"https://godoc.org/gonum.org/v1/gonum/mat",
+			Status{Code: 301, Location: "https://pkg.go.dev/gonum.org/v1/gonum/mat"},
+			[]string{"HEAD godoc.org/gonum.org/v1/gonum/mat"}},
+		// relative location is resolved against the original URL
+		{"https://numpy.org/old", Status{Code: 302, Location: "https://numpy.org/new"},
+			[]string{"HEAD numpy.org/old"}},
+		{"https://porter.io/github.com/go-gota/gota", Status{Code: 200},
+			[]string{"HEAD porter.io/github.com/go-gota/gota", "GET porter.io/github.com/go-gota/gota"}},
+		{"http://example.com/missing", Status{Code: 404},
+			[]string{"HEAD example.com/missing"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.url, func(t *testing.T) {
+			checker, requests := testServer(t)
+			if got := checker.Check(tt.url); got != tt.want {
+				t.Errorf("Check(%s) = %+v, want %+v", tt.url, got, tt.want)
+			}
+			if !reflect.DeepEqual(*requests, tt.requests) {
+				t.Errorf("requests = %q, want %q", *requests, tt.requests)
+			}
+		})
+	}
+}
+
+func TestFixture(t *testing.T) {
+	checker, requests := testServer(t)
+	urls := []string{
+		"https://www.root.cz/clanky/",
+		"https://godoc.org/gonum.org/v1/gonum/mat",
+		"http://example.com/missing",
+	}
+	for _, u := range urls {
+		checker.Check(u)
+	}
+	// cached status is used for the same page
+	checker.Check("https://www.root.cz/clanky/#k10")
+	if len(*requests) != len(urls) {
+		t.Errorf("requests = %q, want one request per page", *requests)
+	}
+
+	filename := filepath.Join(t.TempDir(), "links.json")
+	if err := checker.Fixture.Save(filename); err != nil {
+		t.Fatal(err)
+	}
+	fixture, err := LoadFixture(filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(fixture, checker.Fixture) {
+		t.Errorf("loaded fixture = %+v, want %+v", fixture, checker.Fixture)
+	}
+
+	offline := &Checker{Fixture: fixture, Offline: true}
+	for _, u := range urls {
+		if got, want := offline.Check(u), checker.Fixture[u]; got != want {
+			t.Errorf("offline Check(%s) = %+v, want %+v", u, got, want)
+		}
+	}
+	if got := offline.Check("https://numpy.org/"); got.Error != "not found in fixture" {
+		t.Errorf("offline Check of unknown URL = %+v", got)
+	}
+}
+
+func TestSuggest(t *testing.T) {
+	tests := []struct {
+		url  string
+		want string
+	}{
+		{"https://godoc.org/gonum.org/v1/gonum/mat", "https://pkg.go.dev/gonum.org/v1/gonum/mat"},
+		{"http://www.godoc.org/github.com/go-gota/gota/dataframe#DataFrame",
+			"https://pkg.go.dev/github.com/go-gota/gota/dataframe#DataFrame"},
+		{"https://golang.org/pkg/fmt/", "https://pkg.go.dev/fmt/"},
+		{"https://golang.org/doc/", ""},
+		{"https://numpy.org/", ""},
+	}
+	for _, tt := range tests {
+		if got := Suggest(tt.url); got != tt.want {
+			t.Errorf("Suggest(%s) = %q, want %q", tt.url, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,373 @@
+/*
+Copyright © 2020 Pavel Tisnovsky
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package literate
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Result of comparison of expected and actual output.
+type Result int
+
+// Possible results of comparison, ordered from the best one.
+const (
+	// Match means that text and numbers are the same (within tolerance).
+	Match Result = iota
+	// LayoutDiffers means that only white space differs, typically column
+	// padding of matrices.
+	LayoutDiffers
+	// Mismatch means that text or numbers differ.
+	Mismatch
+)
+
+// String returns textual representation of comparison result.
+func (r Result) String() string {
+	switch r {
+	case Match:
+		return "OK"
+	case LayoutDiffers:
+		return "WARN"
+	default:
+		return "FAIL"
+	}
+}
+
+// Tolerance specifies how much can two numbers differ. Numbers a and b are
+// considered equal when |a-b| <= Abs + Rel*max(|a|, |b|).
+type Tolerance struct {
+	Abs float64
+	Rel float64
+}
+
+// DefaultTolerance is suitable for results printed by the examples.
+var DefaultTolerance = Tolerance{Abs: 1e-9, Rel: 1e-6}
+
+// Equal checks if two numbers are equal within tolerance.
+func (t Tolerance) Equal(a, b float64) bool {
+	if math.IsNaN(a) || math.IsNaN(b) {
+		return math.IsNaN(a) && math.IsNaN(b)
+	}
+	if math.IsInf(a, 0) || math.IsInf(b, 0) {
+		return a == b
+	}
+	return math.Abs(a-b) <= t.Abs+t.Rel*math.Max(math.Abs(a), math.Abs(b))
+}
+
+// numberRegexp matches numbers in output including infinities.
+var numberRegexp = regexp.MustCompile(`[-+]?(?:\d+(?:\.\d*)?|\.\d+)(?:[eE][-+]?\d+)?|[-+]?Inf|NaN`)
+
+// token is either a number or text between numbers.
+type token struct {
+	text     string
+	number   float64
+	isNumber bool
+}
+
+// tokenize splits line into numbers and text.
+func tokenize(line string) []token {
+	var tokens []token
+	last := 0
+	for _, loc := range numberRegexp.FindAllStringIndex(line, -1) {
+		if loc[0] > last {
+			tokens = append(tokens, token{text: line[last:loc[0]]})
+		}
+		text := line[loc[0]:loc[1]]
+		value, err := strconv.ParseFloat(text, 64)
+		if err != nil {
+			tokens = append(tokens, token{text: text})
+		} else {
+			tokens = append(tokens, token{text: text, number: value, isNumber: true})
+		}
+		last = loc[1]
+	}
+	if last < len(line) {
+		tokens = append(tokens, token{text: line[last:]})
+	}
+	return tokens
+}
+
+// squeeze removes all white space, it is used to compare text tokens
+// regardless of layout.
+func squeeze(s string) string {
+	return strings.Join(strings.Fields(s), "")
+}
+
+// compareTokens compares two lists of tokens of the same length.
+func compareTokens(expected, actual []token, tolerance Tolerance) Result {
+	result := Match
+	for i := range expected {
+		e, a := expected[i], actual[i]
+		switch {
+		case e.isNumber != a.isNumber:
+			return Mismatch
+		case e.isNumber:
+			if !tolerance.Equal(e.number, a.number) {
+				return Mismatch
+			}
+		case e.text != a.text:
+			if squeeze(e.text) != squeeze(a.text) {
+				return Mismatch
+			}
+			result = LayoutDiffers
+		}
+	}
+	return result
+}
+
+// removeEmpty removes text tokens that contain only white space, so lines
+// that differ just in padding have the same number of tokens.
+func removeEmpty(tokens []token) []token {
+	var result []token
+	for _, t := range tokens {
+		if t.isNumber || strings.TrimSpace(t.text) != "" {
+			result = append(result, t)
+		}
+	}
+	return result
+}
+
+// CompareLine compares one expected and one actual line.
+func CompareLine(expected, actual string, tolerance Tolerance) Result {
+	if expected == actual {
+		return Match
+	}
+	e := tokenize(expected)
+	a := tokenize(actual)
+	if len(e) == len(a) {
+		return compareTokens(e, a, tolerance)
+	}
+	e = removeEmpty(e)
+	a = removeEmpty(a)
+	if len(e) != len(a) {
+		return Mismatch
+	}
+	if compareTokens(e, a, tolerance) == Mismatch {
+		return Mismatch
+	}
+	return LayoutDiffers
+}
+
+// comparePrefix checks whether actual line starts with expected content,
+// it is used for the line preceding elision ("...").
+func comparePrefix(expected, actual string, tolerance Tolerance) Result {
+	e := removeEmpty(tokenize(expected))
+	a := removeEmpty(tokenize(actual))
+	if len(e) > len(a) {
+		return Mismatch
+	}
+	return compareTokens(e, a[:len(e)], tolerance)
+}
+
+// compareSuffix checks whether actual line ends with expected content,
+// it is used for the line following elision ("...").
+func compareSuffix(expected, actual string, tolerance Tolerance) Result {
+	e := removeEmpty(tokenize(expected))
+	a := removeEmpty(tokenize(actual))
+	if len(e) > len(a) {
+		return Mismatch
+	}
+	return compareTokens(e, a[len(a)-len(e):], tolerance)
+}
+
+// IsElision checks if the line in expected output stands for omitted
+// part of output.
+func IsElision(line string) bool {
+	line = strings.TrimSpace(line)
+	return line == "..." || line == "…"
+}
+
+// splitElisions splits expected output into parts separated by elisions.
+func splitElisions(lines []string) [][]string {
+	var parts [][]string
+	var part []string
+	for _, line := range lines {
+		if IsElision(line) {
+			if part != nil {
+				parts = append(parts, part)
+			}
+			part = nil
+			continue
+		}
+		part = append(part, line)
+	}
+	if part != nil {
+		parts = append(parts, part)
+	}
+	return parts
+}
+
+// matchAt compares expected lines with actual output starting at given
+// position. Prefix/suffix flags control comparison of the first and last
+// line when they are adjacent to elision.
+func matchAt(expected, actual []string, pos int, suffixFirst, prefixLast bool, tolerance Tolerance) Result {
+	if pos+len(expected) > len(actual) {
+		return Mismatch
+	}
+	result := Match
+	for i, line := range expected {
+		var r Result
+		switch {
+		case i == 0 && suffixFirst:
+			r = compareSuffix(line, actual[pos], tolerance)
+		case i == len(expected)-1 && prefixLast:
+			r = comparePrefix(line, actual[pos+i], tolerance)
+		default:
+			r = CompareLine(line, actual[pos+i], tolerance)
+		}
+		if r > result {
+			result = r
+		}
+		if result == Mismatch {
+			return Mismatch
+		}
+	}
+	return result
+}
+
+// BlockMatch describes where expected output was found in actual output.
+type BlockMatch struct {
+	Result Result
+	// First and Last are 0-based indexes of actual output lines, both
+	// are -1 when expected output was not found at all.
+	First int
+	Last  int
+}
+
+// annotationRegexp matches notes such as "// float64" written after the
+// expected value.
+var annotationRegexp = regexp.MustCompile(`\s+//\s.*$`)
+
+// cleanExpected removes blank lines and annotations from expected output.
+// Lines starting with comment (for example comments in printed source code
+// or in DOT output of graphs) are output, not annotations.
+func cleanExpected(lines []string) []string {
+	var result []string
+	for _, line := range lines {
+		if !strings.HasPrefix(strings.TrimSpace(line), "//") {
+			line = annotationRegexp.ReplaceAllString(line, "")
+		}
+		if strings.TrimSpace(line) != "" {
+			result = append(result, line)
+		}
+	}
+	return result
+}
+
+// nonBlank returns non blank lines together with their original indexes.
+func nonBlank(lines []string) ([]string, []int) {
+	var result []string
+	var index []int
+	for i, line := range lines {
+		if strings.TrimSpace(line) != "" {
+			result = append(result, line)
+			index = append(index, i)
+		}
+	}
+	return result, index
+}
+
+// FindBlock searches actual output starting at given position for the
+// expected block. Blank lines are ignored on both sides and parts of block
+// separated by elision are matched independently. When the block is found
+// only after ignoring numeric values, result is Mismatch and First/Last
+// point to the place in actual output. When the block is not found at
+// all, First is set to -1.
+func FindBlock(expected []string, actual []string, from int, tolerance Tolerance) BlockMatch {
+	lines, index := nonBlank(actual)
+	start := sort.SearchInts(index, from)
+	expected = cleanExpected(expected)
+
+	m := findBlock(expected, lines, start, tolerance)
+	if m.First < 0 {
+		loose := Tolerance{Abs: math.Inf(1)}
+		m = findBlock(expected, lines, start, loose)
+		m.Result = Mismatch
+	}
+
+	// map indexes back to the original output
+	if m.First >= 0 && m.Last >= m.First {
+		m.First = index[m.First]
+		m.Last = index[m.Last]
+	} else if m.First >= 0 {
+		m.First = from
+		m.Last = from - 1
+	}
+	return m
+}
+
+func findBlock(expected []string, actual []string, from int, tolerance Tolerance) BlockMatch {
+	parts := splitElisions(expected)
+	if len(parts) == 0 {
+		return BlockMatch{Result: Match, First: from, Last: from - 1}
+	}
+
+	result := Match
+	first := -1
+	pos := from
+	for i, part := range parts {
+		suffixFirst := i > 0
+		prefixLast := i < len(parts)-1
+		found := false
+		for p := pos; p < len(actual); p++ {
+			// the part following elision can start on the same line
+			// where the previous part ended
+			r := matchAt(part, actual, p, suffixFirst, prefixLast, tolerance)
+			if r == Mismatch {
+				continue
+			}
+			if first < 0 {
+				first = p
+			}
+			if r > result {
+				result = r
+			}
+			pos = p + len(part) - 1
+			if !prefixLast {
+				pos++
+			}
+			found = true
+			break
+		}
+		if !found {
+			return BlockMatch{Result: Mismatch, First: -1, Last: -1}
+		}
+	}
+	return BlockMatch{Result: result, First: first, Last: pos - 1}
+}
+
+// Describe returns human readable description of comparison of one
+// expected block.
+func (m BlockMatch) Describe(block Block) string {
+	switch {
+	case m.First < 0:
+		return fmt.Sprintf("%s lines %d-%d: expected output not found", m.Result, block.Start, block.End)
+	case m.Result == Mismatch:
+		return fmt.Sprintf("%s lines %d-%d: values differ beyond tolerance (output lines %d-%d)",
+			m.Result, block.Start, block.End, m.First+1, m.Last+1)
+	case m.Result == LayoutDiffers:
+		return fmt.Sprintf("%s lines %d-%d: values match, layout differs (output lines %d-%d)",
+			m.Result, block.Start, block.End, m.First+1, m.Last+1)
+	default:
+		return fmt.Sprintf("%s lines %d-%d: output lines %d-%d",
+			m.Result, block.Start, block.End, m.First+1, m.Last+1)
+	}
+}
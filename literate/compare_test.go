@@ -0,0 +1,133 @@
+/*
+Copyright © 2020 Pavel Tisnovsky
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package literate
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestToleranceEqual(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b float64
+		want bool
+	}{
+		{"same", 1.5, 1.5, true},
+		{"absolute", 0, 1e-10, true},
+		{"relative", 1e6, 1e6 + 0.5, true},
+		{"beyond tolerance", 1, 1.001, false},
+		{"NaN", math.NaN(), math.NaN(), true},
+		{"NaN and number", math.NaN(), 0, false},
+		{"infinities", math.Inf(1), math.Inf(1), true},
+		{"opposite infinities", math.Inf(1), math.Inf(-1), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := DefaultTolerance.Equal(tt.a, tt.b); got != tt.want {
+				t.Errorf("Equal(%v, %v) = %v, want %v", tt.a, tt.b, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCompareLine(t *testing.T) {
+	tests := []struct {
+		name     string
+		expected string
+		actual   string
+		want     Result
+	}{
+		{"identical", "⎡1  2⎤", "⎡1  2⎤", Match},
+		{"number within tolerance", "x = 0.3333333", "x = 0.33333333333", Match},
+		{"exponent notation", "1e+06", "1000000", Match},
+		{"number beyond tolerance", "x = 0.33", "x = 0.34", Mismatch},
+		{"padding only", "⎡1  2⎤", "⎡1 2⎤", LayoutDiffers},
+		{"padding of columns", "[ 1.5  -2]", "[1.5 -2]", LayoutDiffers},
+		{"text mismatch", "Dims: 2", "Rows: 2", Mismatch},
+		{"missing number", "1 2 3", "1 2", Mismatch},
+		{"number instead of text", "value x", "value 1", Mismatch},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := CompareLine(tt.expected, tt.actual, DefaultTolerance); got != tt.want {
+				t.Errorf("CompareLine(%q, %q) = %v, want %v", tt.expected, tt.actual, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFindBlock(t *testing.T) {
+	actual := []string{
+		"header",
+		"",
+		"⎡1  2⎤",
+		"⎣3  4⎦",
+		"first",
+		"second",
+		"third",
+		"last line 42",
+	}
+	tests := []struct {
+		name     string
+		expected []string
+		from     int
+		want     BlockMatch
+	}{
+		{"exact", []string{"⎡1  2⎤", "⎣3  4⎦"}, 0, BlockMatch{Match, 2, 3}},
+		{"blank lines ignored", []string{"header", "⎡1  2⎤"}, 0, BlockMatch{Match, 0, 2}},
+		{"padding differs", []string{"⎡1 2⎤", "⎣3 4⎦"}, 0, BlockMatch{LayoutDiffers, 2, 3}},
+		{"number differs", []string{"⎡1  2⎤", "⎣3  5⎦"}, 0, BlockMatch{Mismatch, 2, 3}},
+		{"text differs", []string{"fourth"}, 0, BlockMatch{Mismatch, -1, -1}},
+		{"search starts at position", []string{"header"}, 1, BlockMatch{Mismatch, -1, -1}},
+		{"elision at start", []string{"...", "third"}, 0, BlockMatch{Match, 6, 6}},
+		{"elision in the middle", []string{"first", "...", "last line 42"}, 0, BlockMatch{Match, 4, 7}},
+		{"elision at end", []string{"header", "…"}, 0, BlockMatch{Match, 0, 0}},
+		{"elision within line", []string{"⎡1", "...", "2⎤"}, 0, BlockMatch{Match, 2, 2}},
+		{"annotation removed", []string{"last line 42 // int"}, 0, BlockMatch{Match, 7, 7}},
+		{"empty expected", []string{"", "..."}, 3, BlockMatch{Match, 3, 2}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := FindBlock(tt.expected, actual, tt.from, DefaultTolerance); got != tt.want {
+				t.Errorf("FindBlock(%q) = %+v, want %+v", tt.expected, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCleanExpected(t *testing.T) {
+	tests := []struct {
+		name     string
+		expected []string
+		want     []string
+	}{
+		{"blank lines", []string{"a", "", "  ", "b"}, []string{"a", "b"}},
+		{"annotation", []string{"3.5    // float64"}, []string{"3.5"}},
+		{"comment without space is output", []string{"http://example.com"}, []string{"http://example.com"}},
+		{"comment line is output", []string{"  // Node definitions."}, []string{"  // Node definitions."}},
+		{"comment line with trailing comment", []string{"// a // b"}, []string{"// a // b"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := cleanExpected(tt.expected); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("cleanExpected(%q) = %q, want %q", tt.expected, got, tt.want)
+			}
+		})
+	}
+}
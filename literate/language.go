@@ -0,0 +1,66 @@
+/*
+Copyright © 2020 Pavel Tisnovsky
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package literate
+
+import (
+	"path/filepath"
+	"strings"
+)
+
+// Language describes comment conventions of one programming language.
+type Language struct {
+	// Name is used for syntax highlighting, e.g. "go" or "python".
+	Name string
+	// LineComment starts comment that ends at the end of line.
+	LineComment string
+	// BlockComments is set for languages with /* */ comments.
+	BlockComments bool
+}
+
+// Languages supported by the parser.
+var (
+	Go     = Language{Name: "go", LineComment: "//", BlockComments: true}
+	Python = Language{Name: "python", LineComment: "#"}
+)
+
+// LanguageOf returns language of source file based on its extension. Go is
+// used for unknown extensions.
+func LanguageOf(filename string) Language {
+	switch strings.ToLower(filepath.Ext(filename)) {
+	case ".py":
+		return Python
+	default:
+		return Go
+	}
+}
+
+// Title returns text of the first top level heading found in prose written
+// in the default language, or name of the source file when there is no
+// such heading.
+func (d *Document) Title() string {
+	for _, b := range d.BlocksOf(Prose) {
+		if b.Lang != "" {
+			continue
+		}
+		for _, line := range b.Lines {
+			if strings.HasPrefix(line, "# ") {
+				return strings.TrimSpace(line[2:])
+			}
+		}
+	}
+	return filepath.Base(d.Filename)
+}
@@ -0,0 +1,127 @@
+/*
+Copyright © 2020 Pavel Tisnovsky
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package literate
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// Link is Markdown link or image found in prose.
+type Link struct {
+	Text  string
+	URL   string
+	Line  int
+	Image bool
+}
+
+// LinkProblem is malformed Markdown found in prose.
+type LinkProblem struct {
+	Line    int
+	Message string
+}
+
+var (
+	// linkRegexp matches inline link, URL can contain one level of
+	// parentheses, e.g. https://en.wikipedia.org/wiki/R_(programming_language)
+	linkRegexp = regexp.MustCompile(`(!?)\[([^\[\]]*)\]\(([^()\s]*(?:\([^()\s]*\)[^()\s]*)*)\)`)
+	// spacedLinkRegexp matches link with space between text and URL
+	spacedLinkRegexp = regexp.MustCompile(`\[[^\[\]]+\][ \t]+\(\S+\)`)
+	// codeSpanRegexp matches inline code, links are not recognized there
+	codeSpanRegexp = regexp.MustCompile("`[^`]*`")
+	// urlStartRegexp matches line that begins with URL in parentheses
+	urlStartRegexp = regexp.MustCompile(`^\s*\((?:https?://|www\.)`)
+)
+
+// Links returns all links found in prose together with malformed links,
+// i.e. links split across lines, links with space between text and URL,
+// unterminated links and links with empty text or URL.
+func (d *Document) Links() ([]Link, []LinkProblem) {
+	var links []Link
+	var problems []LinkProblem
+	report := func(line int, format string, args ...interface{}) {
+		problems = append(problems, LinkProblem{Line: line, Message: fmt.Sprintf(format, args...)})
+	}
+
+	for _, b := range d.BlocksOf(Prose) {
+		inFence := false
+		for i, text := range b.Lines {
+			number := b.Start + i
+			if strings.HasPrefix(strings.TrimSpace(text), "```") {
+				inFence = !inFence
+				continue
+			}
+			if inFence {
+				continue
+			}
+			text = codeSpanRegexp.ReplaceAllStringFunc(text, func(s string) string {
+				return strings.Repeat(" ", len(s))
+			})
+
+			for _, m := range linkRegexp.FindAllStringSubmatch(text, -1) {
+				link := Link{Text: m[2], URL: m[3], Line: number, Image: m[1] == "!"}
+				links = append(links, link)
+				switch {
+				case link.URL == "":
+					report(number, "link %q has empty URL", link.Text)
+				case strings.TrimSpace(link.Text) == "":
+					report(number, "link to %s has empty text", link.URL)
+				case strings.TrimSpace(link.Text) != link.Text:
+					report(number, "text of link %q has leading or trailing spaces", link.Text)
+				}
+			}
+			rest := linkRegexp.ReplaceAllString(text, "")
+
+			for _, m := range spacedLinkRegexp.FindAllString(rest, -1) {
+				report(number, "space between link text and URL: %s", m)
+			}
+			rest = spacedLinkRegexp.ReplaceAllString(rest, "")
+			if strings.Contains(rest, "](") {
+				report(number, "unterminated link: %s", strings.TrimSpace(rest[strings.Index(rest, "]("):]))
+			}
+			trimmed := strings.TrimSpace(rest)
+			if strings.HasSuffix(trimmed, "]") && strings.Contains(trimmed, "[") &&
+				i+1 < len(b.Lines) && urlStartRegexp.MatchString(b.Lines[i+1]) {
+				report(number, "link text and URL are split across lines %d and %d", number, number+1)
+			}
+		}
+	}
+	return links, problems
+}
+
+// Duplicate is link to URL that was already linked earlier.
+type Duplicate struct {
+	Link
+	// First is line with the first link to the same URL.
+	First int
+}
+
+// DuplicateLinks returns all links to URL that was already linked earlier
+// in the document.
+func DuplicateLinks(links []Link) []Duplicate {
+	first := map[string]int{}
+	var duplicates []Duplicate
+	for _, link := range links {
+		if line, found := first[link.URL]; found {
+			duplicates = append(duplicates, Duplicate{Link: link, First: line})
+			continue
+		}
+		first[link.URL] = link.Line
+	}
+	return duplicates
+}
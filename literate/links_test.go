@@ -0,0 +1,104 @@
+/*
+Copyright © 2020 Pavel Tisnovsky
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package literate
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// proseDocument parses Go source containing given lines of prose.
+func proseDocument(t *testing.T, prose ...string) *Document {
+	t.Helper()
+	src := "package main\n\n// " + strings.Join(prose, "\n// ") + "\nfunc main() {\n}\n"
+	doc, err := Parse("links.go", []byte(src))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return doc
+}
+
+func TestLinks(t *testing.T) {
+	doc := proseDocument(t,
+		"Viz [R](https://en.wikipedia.org/wiki/R_(programming_language)) a",
+		"obrázek ![matice](matrix.png), ale ne `[x](y)`.",
+	)
+	links, problems := doc.Links()
+	want := []Link{
+		{Text: "R", URL: "https://en.wikipedia.org/wiki/R_(programming_language)", Line: 3},
+		{Text: "matice", URL: "matrix.png", Line: 4, Image: true},
+	}
+	if !reflect.DeepEqual(links, want) {
+		t.Errorf("links = %+v, want %+v", links, want)
+	}
+	if len(problems) != 0 {
+		t.Errorf("problems = %+v, want none", problems)
+	}
+}
+
+func TestLinkProblems(t *testing.T) {
+	tests := []struct {
+		name  string
+		prose []string
+		want  []LinkProblem
+	}{
+		// as in gonum.go, text and URL on two lines
+		{"split across lines", []string{
+			"lze najít na [této adrese]",
+			"(https://medium.com/@ankur_anand/a-closer-look)",
+		}, []LinkProblem{{3, "link text and URL are split across lines 3 and 4"}}},
+		{"spaced", []string{
+			"na [této adrese] (https://www.root.cz/)",
+		}, []LinkProblem{{3, "space between link text and URL: [této adrese] (https://www.root.cz/)"}}},
+		{"unterminated", []string{
+			"viz [NumPy](https://numpy.org/ a další",
+		}, []LinkProblem{{3, "unterminated link: ](https://numpy.org/ a další"}}},
+		// as in gonum.go, space at the end of link text
+		{"trailing space", []string{
+			"1. [A repository for plotting ](https://github.com/gonum/plot)",
+		}, []LinkProblem{{3, `text of link "A repository for plotting " has leading or trailing spaces`}}},
+		{"empty URL", []string{
+			"[gonum]()",
+		}, []LinkProblem{{3, `link "gonum" has empty URL`}}},
+		{"fenced code", []string{
+			"```",
+			"[x] (y)",
+			"```",
+		}, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, problems := proseDocument(t, tt.prose...).Links()
+			if !reflect.DeepEqual(problems, tt.want) {
+				t.Errorf("problems = %+v, want %+v", problems, tt.want)
+			}
+		})
+	}
+}
+
+func TestDuplicateLinks(t *testing.T) {
+	links := []Link{
+		{Text: "NumPy", URL: "https://numpy.org/", Line: 1},
+		{Text: "gonum", URL: "https://github.com/gonum", Line: 2},
+		{Text: "NumPy", URL: "https://numpy.org/", Line: 5},
+	}
+	want := []Duplicate{{Link: links[2], First: 1}}
+	if got := DuplicateLinks(links); !reflect.DeepEqual(got, want) {
+		t.Errorf("DuplicateLinks = %+v, want %+v", got, want)
+	}
+}
@@ -0,0 +1,309 @@
+/*
+Copyright © 2020 Pavel Tisnovsky
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package literate parses literate sources used in this repository into
+// a sequence of blocks. Each block is either prose written in Markdown,
+// code, or expected output of the code. Expected output can be written
+// either as Markdown code block inside comments (indented by four spaces,
+// see gonum.go) or as a block comment (see gonum_output_as_comments.go).
+// Both Go sources with // comments and Python sources with # comments
+// (see consumer_benchmarks.py) are supported.
+//
+// Prose can be written in several languages. Lines without language marker
+// are written in the default language of the document, translations are
+// written on lines starting with language marker, for example:
+//
+//	// Matice je dvourozměrné pole.
+//	//[en] Matrix is two-dimensional array.
+package literate
+
+import (
+	"bufio"
+	"bytes"
+	"os"
+	"regexp"
+	"strings"
+)
+
+// Kind represents the kind of block.
+type Kind int
+
+// Kinds of blocks found in literate sources.
+const (
+	Prose Kind = iota
+	Code
+	Output
+)
+
+// String returns textual representation of block kind.
+func (k Kind) String() string {
+	switch k {
+	case Prose:
+		return "prose"
+	case Code:
+		return "code"
+	case Output:
+		return "output"
+	default:
+		return "unknown"
+	}
+}
+
+// Block is a run of consecutive lines of the same kind. Line numbers are
+// 1-based and refer to the original source, End is inclusive.
+type Block struct {
+	Kind  Kind
+	Lines []string
+	Start int
+	End   int
+	// Lang is language of prose, it is empty for prose written in the
+	// default language of document.
+	Lang string
+}
+
+// Text returns all lines of block joined by new lines.
+func (b Block) Text() string {
+	return strings.Join(b.Lines, "\n")
+}
+
+// Document is parsed literate source.
+type Document struct {
+	Filename string
+	Language Language
+	Blocks   []Block
+}
+
+// OutputIndent is the Markdown indentation of code blocks, the comment
+// marker is followed by one space and then by four spaces of indentation.
+const OutputIndent = "     "
+
+// ParseFile reads and parses literate source stored in given file.
+func ParseFile(filename string) (*Document, error) {
+	src, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	return Parse(filename, src)
+}
+
+// Parse parses literate source, language is selected by file extension.
+func Parse(filename string, src []byte) (*Document, error) {
+	return ParseLanguage(filename, src, LanguageOf(filename))
+}
+
+// ParseLanguage parses literate source written in given language.
+func ParseLanguage(filename string, src []byte, language Language) (*Document, error) {
+	p := parser{doc: &Document{Filename: filename, Language: language}}
+
+	scanner := bufio.NewScanner(bytes.NewReader(src))
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	line := 0
+	for scanner.Scan() {
+		line++
+		p.line(line, scanner.Text())
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	p.flush()
+	return p.doc, nil
+}
+
+// parser holds state of parsing.
+type parser struct {
+	doc     *Document
+	current *Block
+
+	// state used for block comments
+	inComment     bool
+	commentIndent string
+	commentCode   bool
+
+	// fenced code block (```) in prose
+	inFence bool
+
+	// language of the current prose line
+	lang string
+}
+
+// line processes one line of source.
+func (p *parser) line(number int, text string) {
+	trimmed := strings.TrimLeft(text, " \t")
+	indent := text[:len(text)-len(trimmed)]
+
+	if p.inComment {
+		p.commentLine(number, text, trimmed)
+		return
+	}
+	language := p.doc.Language
+
+	switch {
+	case number <= 2 && isDirective(language, trimmed):
+		p.add(Code, number, text)
+	case language.BlockComments && strings.HasPrefix(trimmed, "/*"):
+		// block comment at the top level (license) is kept as code,
+		// indented block comment contains expected output
+		p.inComment = true
+		p.commentIndent = indent
+		p.commentCode = indent == ""
+		if p.commentCode {
+			p.add(Code, number, text)
+		} else {
+			p.flush()
+		}
+		if strings.Contains(trimmed[2:], "*/") {
+			p.inComment = false
+		}
+	case strings.HasPrefix(trimmed, language.LineComment):
+		p.commentText(number, strings.TrimPrefix(trimmed, language.LineComment))
+	case trimmed == "":
+		p.blank(number)
+	default:
+		// unterminated fence can not continue after code
+		p.inFence = false
+		p.add(Code, number, text)
+	}
+}
+
+// isDirective checks for shebang and encoding declaration that can be
+// written at the beginning of Python sources and for build constraint
+// written at the beginning of Go sources.
+func isDirective(language Language, trimmed string) bool {
+	switch language.Name {
+	case Python.Name:
+		return strings.HasPrefix(trimmed, "#!") ||
+			strings.HasPrefix(trimmed, "# coding") ||
+			strings.HasPrefix(trimmed, "# -*-")
+	case Go.Name:
+		return strings.HasPrefix(trimmed, "//go:build ")
+	}
+	return false
+}
+
+// langRegexp matches language marker at the beginning of comment.
+var langRegexp = regexp.MustCompile(`^\[([a-z]{2})\]`)
+
+// commentText handles content of line comment.
+func (p *parser) commentText(number int, text string) {
+	p.lang = ""
+	if match := langRegexp.FindStringSubmatch(text); match != nil {
+		// translated prose never contains expected output
+		p.lang = match[1]
+		p.add(Prose, number, strings.TrimPrefix(text[len(match[0]):], " "))
+		return
+	}
+
+	// content of fenced code block is prose, even when it is indented
+	if strings.HasPrefix(strings.TrimSpace(text), "```") {
+		p.inFence = !p.inFence
+		p.add(Prose, number, strings.TrimPrefix(text, " "))
+		return
+	}
+	if p.inFence {
+		p.add(Prose, number, strings.TrimPrefix(text, " "))
+		return
+	}
+
+	switch {
+	case strings.HasPrefix(text, OutputIndent):
+		p.add(Output, number, text[len(OutputIndent):])
+	case text == "" && p.current != nil && p.current.Kind == Output:
+		// empty comment line might separate two parts of output
+		p.add(Output, number, "")
+	default:
+		p.add(Prose, number, strings.TrimPrefix(text, " "))
+	}
+}
+
+// commentLine handles line inside block comment.
+func (p *parser) commentLine(number int, text, trimmed string) {
+	end := strings.HasPrefix(trimmed, "*/")
+	if p.commentCode {
+		p.add(Code, number, text)
+	} else if !end {
+		// content of output block is indented by three spaces after
+		// indentation of the comment start
+		content := strings.TrimPrefix(text, p.commentIndent)
+		content = strings.TrimPrefix(content, "   ")
+		p.add(Output, number, content)
+	}
+	if end || strings.Contains(trimmed, "*/") {
+		p.inComment = false
+		if !p.commentCode {
+			p.flush()
+		}
+	}
+}
+
+// blank handles empty line. Blank lines separate paragraphs in prose,
+// they are kept in code and they end output blocks.
+func (p *parser) blank(number int) {
+	if p.current == nil {
+		return
+	}
+	switch p.current.Kind {
+	case Output:
+		p.flush()
+	default:
+		p.current.Lines = append(p.current.Lines, "")
+		p.current.End = number
+	}
+}
+
+// add appends line to the current block, new block is started when the
+// kind or language of prose differs.
+func (p *parser) add(kind Kind, number int, text string) {
+	lang := ""
+	if kind == Prose {
+		lang = p.lang
+	}
+	if p.current != nil && (p.current.Kind != kind || p.current.Lang != lang) {
+		p.flush()
+	}
+	if p.current == nil {
+		p.current = &Block{Kind: kind, Start: number, Lang: lang}
+	}
+	p.current.Lines = append(p.current.Lines, text)
+	p.current.End = number
+}
+
+// flush finishes the current block, trailing empty lines are removed.
+func (p *parser) flush() {
+	b := p.current
+	p.current = nil
+	if b == nil {
+		return
+	}
+	for len(b.Lines) > 0 && strings.TrimSpace(b.Lines[len(b.Lines)-1]) == "" {
+		b.Lines = b.Lines[:len(b.Lines)-1]
+		b.End--
+	}
+	if len(b.Lines) == 0 {
+		return
+	}
+	p.doc.Blocks = append(p.doc.Blocks, *b)
+}
+
+// BlocksOf returns all blocks of given kind.
+func (d *Document) BlocksOf(kind Kind) []Block {
+	var blocks []Block
+	for _, b := range d.Blocks {
+		if b.Kind == kind {
+			blocks = append(blocks, b)
+		}
+	}
+	return blocks
+}
@@ -0,0 +1,76 @@
+/*
+Copyright © 2020 Pavel Tisnovsky
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package literate
+
+import "sort"
+
+// ProseGroup contains all prose written between two code blocks, both in
+// the default language and in all translations. Blocks are indexed by
+// language, the default language has empty name.
+type ProseGroup struct {
+	Start  int
+	End    int
+	Blocks map[string][]Block
+}
+
+// ProseGroups returns all groups of prose found in document. Translations
+// belong to the same group as the prose they translate.
+func (d *Document) ProseGroups() []ProseGroup {
+	var groups []ProseGroup
+	inGroup := false
+	for _, b := range d.Blocks {
+		if b.Kind != Prose {
+			inGroup = false
+			continue
+		}
+		if !inGroup {
+			groups = append(groups, ProseGroup{Start: b.Start, Blocks: map[string][]Block{}})
+			inGroup = true
+		}
+		current := &groups[len(groups)-1]
+		current.Blocks[b.Lang] = append(current.Blocks[b.Lang], b)
+		current.End = b.End
+	}
+	return groups
+}
+
+// Translations returns sorted list of languages used for translations.
+func (d *Document) Translations() []string {
+	found := map[string]bool{}
+	for _, b := range d.Blocks {
+		if b.Kind == Prose && b.Lang != "" {
+			found[b.Lang] = true
+		}
+	}
+	langs := make([]string, 0, len(found))
+	for lang := range found {
+		langs = append(langs, lang)
+	}
+	sort.Strings(langs)
+	return langs
+}
+
+// SetDefault sets the default language of document. Prose marked with the
+// default language, for example //[cs] in Czech document, is then handled
+// in the same way as prose without language marker.
+func (d *Document) SetDefault(lang string) {
+	for i := range d.Blocks {
+		if d.Blocks[i].Lang == lang {
+			d.Blocks[i].Lang = ""
+		}
+	}
+}
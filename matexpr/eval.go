@@ -0,0 +1,410 @@
+/*
+Copyright © 2020 Pavel Tisnovsky
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package matexpr
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+// Vars maps names of variables to their values. Values can be numbers
+// (float64 or int), vectors (mat.Vector, e.g. *mat.VecDense) or matrices
+// (mat.Matrix, e.g. *mat.Dense).
+type Vars map[string]interface{}
+
+// Value is result of evaluation, float64 for scalars, *mat.VecDense for
+// column vectors and mat.Matrix for other matrices (including transposed
+// vectors).
+type Value interface{}
+
+// Eval parses and evaluates expression.
+func Eval(expr string, vars Vars) (Value, error) {
+	e, err := Parse(expr)
+	if err != nil {
+		return nil, err
+	}
+	return e.Eval(vars)
+}
+
+// MustEval is like Eval, but panics when expression can not be parsed or
+// evaluated. It simplifies use of expressions with constant text.
+func MustEval(expr string, vars Vars) Value {
+	value, err := Eval(expr, vars)
+	if err != nil {
+		panic(fmt.Sprintf("matexpr: %q: %v", expr, err))
+	}
+	return value
+}
+
+// Matrix evaluates expression with result of any shape and returns it as
+// dense matrix. Scalars are returned as 1x1 matrices and vectors as
+// column matrices.
+func Matrix(expr string, vars Vars) (*mat.Dense, error) {
+	value, err := Eval(expr, vars)
+	if err != nil {
+		return nil, err
+	}
+	if x, ok := value.(float64); ok {
+		return mat.NewDense(1, 1, []float64{x}), nil
+	}
+	return mat.DenseCopyOf(value.(mat.Matrix)), nil
+}
+
+// Scalar evaluates expression with scalar result.
+func Scalar(expr string, vars Vars) (float64, error) {
+	value, err := Eval(expr, vars)
+	if err != nil {
+		return 0, err
+	}
+	x, ok := value.(float64)
+	if !ok {
+		return 0, fmt.Errorf("result is %s, not scalar", describe(value))
+	}
+	return x, nil
+}
+
+// Format returns value formatted in the same way as in the examples, i.e.
+// matrices and vectors by mat.Formatted.
+func Format(value Value) string {
+	switch v := value.(type) {
+	case float64:
+		return strconv.FormatFloat(v, 'g', -1, 64)
+	case mat.Matrix:
+		return fmt.Sprintf("%v", mat.Formatted(v))
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// Eval evaluates parsed expression. Errors of gonum (mostly mismatched
+// dimensions) are returned as errors instead of panics.
+func (e *Expr) Eval(vars Vars) (result Value, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			if rerr, ok := r.(error); ok {
+				err = rerr
+				return
+			}
+			panic(r)
+		}
+	}()
+	return eval(e.root, vars)
+}
+
+// describe returns kind and dimensions of value for error messages.
+func describe(value Value) string {
+	switch v := value.(type) {
+	case float64:
+		return "scalar"
+	case *mat.VecDense:
+		return fmt.Sprintf("vector of length %d", v.Len())
+	case mat.Matrix:
+		r, c := v.Dims()
+		return fmt.Sprintf("%dx%d matrix", r, c)
+	default:
+		return fmt.Sprintf("%T", v)
+	}
+}
+
+// variable converts value of variable to one of the types of Value.
+func variable(name string, vars Vars) (Value, error) {
+	value, ok := vars[name]
+	if !ok {
+		return nil, fmt.Errorf("undefined variable %s", name)
+	}
+	switch v := value.(type) {
+	case float64:
+		return v, nil
+	case int:
+		return float64(v), nil
+	case *mat.VecDense:
+		return v, nil
+	case mat.Vector:
+		if _, c := v.Dims(); c == 1 {
+			return mat.VecDenseCopyOf(v), nil
+		}
+		return v, nil
+	case mat.Matrix:
+		return v, nil
+	default:
+		return nil, fmt.Errorf("variable %s has unsupported type %T", name, value)
+	}
+}
+
+// eval evaluates one node of expression.
+func eval(n node, vars Vars) (Value, error) {
+	switch n := n.(type) {
+	case numberNode:
+		return n.value, nil
+	case variableNode:
+		return variable(n.name, vars)
+	case unaryNode:
+		operand, err := eval(n.operand, vars)
+		if err != nil {
+			return nil, err
+		}
+		return scale(-1, operand), nil
+	case transposeNode:
+		operand, err := eval(n.operand, vars)
+		if err != nil {
+			return nil, err
+		}
+		if x, ok := operand.(float64); ok {
+			return x, nil
+		}
+		return transpose(operand.(mat.Matrix)), nil
+	case binaryNode:
+		left, err := eval(n.left, vars)
+		if err != nil {
+			return nil, err
+		}
+		right, err := eval(n.right, vars)
+		if err != nil {
+			return nil, err
+		}
+		return binary(n.op, left, right)
+	case indexNode:
+		return index(n, vars)
+	case callNode:
+		args := make([]Value, len(n.args))
+		for i, arg := range n.args {
+			value, err := eval(arg, vars)
+			if err != nil {
+				return nil, err
+			}
+			args[i] = value
+		}
+		return call(n.name, args)
+	}
+	return nil, fmt.Errorf("unknown node %T", n)
+}
+
+// transpose returns transposed matrix, transposition of transposed vector
+// is vector again.
+func transpose(m mat.Matrix) Value {
+	if t, ok := m.(mat.Transpose); ok {
+		if v, ok := t.Matrix.(*mat.VecDense); ok {
+			return v
+		}
+	}
+	return m.T()
+}
+
+// scale multiplies value by scalar.
+func scale(f float64, value Value) Value {
+	switch v := value.(type) {
+	case float64:
+		return f * v
+	case *mat.VecDense:
+		var result mat.VecDense
+		result.ScaleVec(f, v)
+		return &result
+	default:
+		var result mat.Dense
+		result.Scale(f, v.(mat.Matrix))
+		return &result
+	}
+}
+
+// binary evaluates binary operator.
+func binary(op string, left, right Value) (Value, error) {
+	x, leftScalar := left.(float64)
+	y, rightScalar := right.(float64)
+	if leftScalar && rightScalar {
+		switch op {
+		case "+":
+			return x + y, nil
+		case "-":
+			return x - y, nil
+		case "*", ".*":
+			return x * y, nil
+		default:
+			return x / y, nil
+		}
+	}
+
+	switch op {
+	case "*":
+		if leftScalar {
+			return scale(x, right), nil
+		}
+		if rightScalar {
+			return scale(y, left), nil
+		}
+		return multiply(left.(mat.Matrix), right.(mat.Matrix)), nil
+	case "/":
+		if !rightScalar {
+			return nil, fmt.Errorf("can not divide by %s", describe(right))
+		}
+		return scale(1/y, left), nil
+	case ".*":
+		if leftScalar || rightScalar {
+			return binary("*", left, right)
+		}
+	}
+	if leftScalar || rightScalar {
+		return nil, fmt.Errorf("operator %s can not be used for %s and %s", op, describe(left), describe(right))
+	}
+
+	a, b := left.(mat.Matrix), right.(mat.Matrix)
+	u, uok := a.(*mat.VecDense)
+	v, vok := b.(*mat.VecDense)
+	if uok && vok {
+		var result mat.VecDense
+		switch op {
+		case "+":
+			result.AddVec(u, v)
+		case "-":
+			result.SubVec(u, v)
+		case ".*":
+			result.MulElemVec(u, v)
+		case "./":
+			result.DivElemVec(u, v)
+		}
+		return &result, nil
+	}
+	var result mat.Dense
+	switch op {
+	case "+":
+		result.Add(a, b)
+	case "-":
+		result.Sub(a, b)
+	case ".*":
+		result.MulElem(a, b)
+	case "./":
+		result.DivElem(a, b)
+	}
+	return &result, nil
+}
+
+// multiply returns matrix product, product of matrix and vector is vector.
+func multiply(a, b mat.Matrix) Value {
+	if v, ok := b.(*mat.VecDense); ok {
+		var result mat.VecDense
+		result.MulVec(a, v)
+		return &result
+	}
+	var result mat.Dense
+	result.Mul(a, b)
+	return &result
+}
+
+// integer evaluates index and checks that it is whole number.
+func integer(n node, vars Vars) (int, error) {
+	value, err := eval(n, vars)
+	if err != nil {
+		return 0, err
+	}
+	x, ok := value.(float64)
+	if !ok || x != math.Trunc(x) {
+		return 0, fmt.Errorf("index has to be integer, not %s", Format(value))
+	}
+	return int(x), nil
+}
+
+// index evaluates element of vector or matrix and slice of vector.
+func index(n indexNode, vars Vars) (Value, error) {
+	operand, err := eval(n.operand, vars)
+	if err != nil {
+		return nil, err
+	}
+	bounds := make([]int, 0, 4)
+	for i := range n.low {
+		low, err := integer(n.low[i], vars)
+		if err != nil {
+			return nil, err
+		}
+		bounds = append(bounds, low)
+		if n.high[i] != nil {
+			high, err := integer(n.high[i], vars)
+			if err != nil {
+				return nil, err
+			}
+			bounds = append(bounds, high)
+		}
+	}
+
+	switch v := operand.(type) {
+	case *mat.VecDense:
+		if len(n.low) != 1 {
+			return nil, fmt.Errorf("vector needs one index")
+		}
+		if n.high[0] != nil {
+			return v.SliceVec(bounds[0], bounds[1]), nil
+		}
+		return v.AtVec(bounds[0]), nil
+	case mat.Matrix:
+		if len(n.low) != 2 || n.high[0] != nil || n.high[1] != nil {
+			return nil, fmt.Errorf("matrix needs two indexes, slices are supported for vectors only")
+		}
+		return v.At(bounds[0], bounds[1]), nil
+	}
+	return nil, fmt.Errorf("%s can not be indexed", describe(operand))
+}
+
+// functions contains number of arguments of supported functions.
+var functions = map[string]int{
+	"det":   1,
+	"trace": 1,
+	"inv":   1,
+	"dot":   2,
+	"norm":  1,
+}
+
+// call evaluates function.
+func call(name string, args []Value) (Value, error) {
+	count, ok := functions[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown function %s", name)
+	}
+	if len(args) != count {
+		return nil, fmt.Errorf("function %s needs %d argument(s), got %d", name, count, len(args))
+	}
+	matrices := make([]mat.Matrix, len(args))
+	for i, arg := range args {
+		m, ok := arg.(mat.Matrix)
+		if !ok {
+			return nil, fmt.Errorf("argument of function %s has to be matrix or vector, not %s", name, describe(arg))
+		}
+		matrices[i] = m
+	}
+
+	switch name {
+	case "det":
+		return mat.Det(matrices[0]), nil
+	case "trace":
+		return mat.Trace(matrices[0]), nil
+	case "inv":
+		var result mat.Dense
+		if err := result.Inverse(matrices[0]); err != nil {
+			return nil, err
+		}
+		return &result, nil
+	case "dot":
+		u, uok := matrices[0].(*mat.VecDense)
+		v, vok := matrices[1].(*mat.VecDense)
+		if !uok || !vok {
+			return nil, fmt.Errorf("arguments of function dot have to be vectors")
+		}
+		return mat.Dot(u, v), nil
+	default:
+		return mat.Norm(matrices[0], 2), nil
+	}
+}
@@ -0,0 +1,181 @@
+/*
+Copyright © 2020 Pavel Tisnovsky
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package matexpr
+
+import (
+	"math"
+	"reflect"
+	"strings"
+	"testing"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+// testVars are variables used by all tests.
+func testVars() Vars {
+	return Vars{
+		"a": mat.NewDense(2, 2, []float64{1, 2, 3, 4}),
+		"b": mat.NewDense(2, 2, []float64{0, 1, 1, 0}),
+		"c": mat.NewDense(2, 3, []float64{1, 2, 3, 4, 5, 6}),
+		"u": mat.NewVecDense(2, []float64{1, 2}),
+		"v": mat.NewVecDense(2, []float64{3, 4}),
+		"w": mat.NewVecDense(3, []float64{1, 1, 1}),
+		"s": 2,
+		"x": 0.5,
+	}
+}
+
+func TestScalar(t *testing.T) {
+	tests := []struct {
+		expr string
+		want float64
+	}{
+		// precedence and associativity
+		{"1 + 2 * 3", 7},
+		{"(1 + 2) * 3", 9},
+		{"10 - 4 - 3", 3},
+		{"16 / 4 / 2", 2},
+		{"2 * 3 / 4", 1.5},
+		{"-2 * 3 + 1", -5},
+		{"- -2", 2},
+		{"-s * x", -1},
+		{"1.5e1 + .5", 15.5},
+		// elements and functions
+		{"a[1, 0] + u[1]", 5},
+		{"a'[1, 0]", 2},
+		{"det(a)", -2},
+		{"trace(a)", 5},
+		{"dot(u, v)", 11},
+		{"norm(v)", 5},
+		{"inv(a)[0, 0]", -2},
+		{"(a * b)[0, 0]", 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			got, err := Scalar(tt.expr, testVars())
+			if err != nil {
+				t.Fatal(err)
+			}
+			if math.Abs(got-tt.want) > 1e-12 {
+				t.Errorf("%s = %v, want %v", tt.expr, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMatrix(t *testing.T) {
+	tests := []struct {
+		expr string
+		want []float64
+		r, c int
+	}{
+		// product binds tighter than sum, transposition tighter than product
+		{"a + a * b", []float64{3, 3, 7, 7}, 2, 2},
+		{"(a + a) * b", []float64{4, 2, 8, 6}, 2, 2},
+		{"a - b - a", []float64{0, -1, -1, 0}, 2, 2},
+		{"a * b'", []float64{2, 1, 4, 3}, 2, 2},
+		{"(a * b)'", []float64{2, 4, 1, 3}, 2, 2},
+		{"a''", []float64{1, 2, 3, 4}, 2, 2},
+		{"-a + a", []float64{0, 0, 0, 0}, 2, 2},
+		{"2 * a / 4", []float64{0.5, 1, 1.5, 2}, 2, 2},
+		{"a .* b", []float64{0, 2, 3, 0}, 2, 2},
+		{"a ./ a", []float64{1, 1, 1, 1}, 2, 2},
+		{"c'", []float64{1, 4, 2, 5, 3, 6}, 3, 2},
+		{"inv(a) * a", []float64{1, 0, 0, 1}, 2, 2},
+		{"inv(a')", []float64{-2, 1.5, 1, -0.5}, 2, 2},
+		// vectors
+		{"a * u", []float64{5, 11}, 2, 1},
+		{"u + v .* u", []float64{4, 10}, 2, 1},
+		{"u'", []float64{1, 2}, 1, 2},
+		{"u * v'", []float64{3, 4, 6, 8}, 2, 2},
+		{"u' * v", []float64{11}, 1, 1},
+		{"c * w", []float64{6, 15}, 2, 1},
+		{"w[1:3]", []float64{1, 1}, 2, 1},
+		{"s", []float64{2}, 1, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			got, err := Matrix(tt.expr, testVars())
+			if err != nil {
+				t.Fatal(err)
+			}
+			want := mat.NewDense(tt.r, tt.c, tt.want)
+			if !mat.EqualApprox(got, want, 1e-12) {
+				t.Errorf("%s =\n%v\nwant\n%v", tt.expr, mat.Formatted(got), mat.Formatted(want))
+			}
+		})
+	}
+}
+
+func TestErrors(t *testing.T) {
+	tests := []struct {
+		expr string
+		want string
+	}{
+		// parse errors
+		{"", "unexpected"},
+		{"a +", "unexpected"},
+		{"(a + b", "expected"},
+		{"a b", "unexpected"},
+		{"a # b", "unexpected character"},
+		{"a[1", "expected"},
+		// unknown identifiers
+		{"a + d", "undefined variable d"},
+		{"inv(d)", "undefined variable d"},
+		{"foo(a)", "unknown function foo"},
+		// dimension mismatch
+		{"a + c", "mismatch"},
+		{"c * c", "mismatch"},
+		{"u + w", "mismatch"},
+		{"a * w", "mismatch"},
+		{"dot(u, w)", "mismatch"},
+		// wrong kinds of operands
+		{"a / b", "can not divide by 2x2 matrix"},
+		{"a + 1", "operator + can not be used for 2x2 matrix and scalar"},
+		{"det(s)", "has to be matrix or vector, not scalar"},
+		{"det(a, b)", "needs 1 argument(s), got 2"},
+		{"dot(a, b)", "have to be vectors"},
+		{"u[0.5]", "index has to be integer"},
+		{"a[0]", "matrix needs two indexes"},
+		{"s[0]", "scalar can not be indexed"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			_, err := Eval(tt.expr, testVars())
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("%s: error %v, want error containing %q", tt.expr, err, tt.want)
+			}
+		})
+	}
+}
+
+func TestScalarOfMatrix(t *testing.T) {
+	if _, err := Scalar("a", testVars()); err == nil || err.Error() != "result is 2x2 matrix, not scalar" {
+		t.Errorf("error = %v", err)
+	}
+}
+
+func TestVariables(t *testing.T) {
+	e, err := Parse("a * (u + v)' + inv(a)[0, s] * a")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{"a", "u", "v", "s"}
+	if got := e.Variables(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Variables() = %v, want %v", got, want)
+	}
+}
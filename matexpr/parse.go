@@ -0,0 +1,375 @@
+/*
+Copyright © 2020 Pavel Tisnovsky
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package matexpr evaluates expressions with matrices and vectors from the
+// gonum/mat package. Go does not support operator overloading, so instead
+// of
+//
+//	var c mat.Dense
+//	c.Mul(m2, m3)
+//	c.Add(&c, m3)
+//
+// the expression can be written as string:
+//
+//	c, err := matexpr.Matrix("m2 * m3 + m3", matexpr.Vars{"m2": m2, "m3": m3})
+//
+// Supported operators, from the highest priority:
+//
+//	A'  v[i]  v[i:j]  A[i, j]  transposition, element of vector, slice of
+//	                           vector (SliceVec), element of matrix
+//	-A                         negation
+//	A * B  A .* B  A / s  A ./ B  matrix product (or scaling by scalar),
+//	                           element-wise product, division by scalar,
+//	                           element-wise division
+//	A + B  A - B               sum and difference
+//
+// and functions det(A), trace(A), inv(A), dot(u, v) and norm(v).
+package matexpr
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// token is lexical unit of expression.
+type token struct {
+	kind  tokenKind
+	text  string
+	value float64
+	pos   int
+}
+
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenNumber
+	tokenIdent
+	tokenOperator
+)
+
+// operators contains all operators and punctuation, longer ones first.
+var operators = []string{".*", "./", "+", "-", "*", "/", "'", "(", ")", "[", "]", ":", ","}
+
+// tokenize splits expression into tokens.
+func tokenize(expr string) ([]token, error) {
+	var tokens []token
+	for pos := 0; pos < len(expr); {
+		c := rune(expr[pos])
+		switch {
+		case unicode.IsSpace(c):
+			pos++
+		case unicode.IsDigit(c) || c == '.' && pos+1 < len(expr) && unicode.IsDigit(rune(expr[pos+1])):
+			end := pos
+			for end < len(expr) && (unicode.IsDigit(rune(expr[end])) || expr[end] == '.' ||
+				expr[end] == 'e' || expr[end] == 'E' ||
+				(expr[end] == '-' || expr[end] == '+') && (expr[end-1] == 'e' || expr[end-1] == 'E')) {
+				end++
+			}
+			value, err := strconv.ParseFloat(expr[pos:end], 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid number %q at position %d", expr[pos:end], pos+1)
+			}
+			tokens = append(tokens, token{kind: tokenNumber, text: expr[pos:end], value: value, pos: pos})
+			pos = end
+		case unicode.IsLetter(c) || c == '_':
+			end := pos
+			for end < len(expr) && (unicode.IsLetter(rune(expr[end])) || unicode.IsDigit(rune(expr[end])) || expr[end] == '_') {
+				end++
+			}
+			tokens = append(tokens, token{kind: tokenIdent, text: expr[pos:end], pos: pos})
+			pos = end
+		default:
+			found := false
+			for _, op := range operators {
+				if strings.HasPrefix(expr[pos:], op) {
+					tokens = append(tokens, token{kind: tokenOperator, text: op, pos: pos})
+					pos += len(op)
+					found = true
+					break
+				}
+			}
+			if !found {
+				return nil, fmt.Errorf("unexpected character %q at position %d", c, pos+1)
+			}
+		}
+	}
+	return append(tokens, token{kind: tokenEOF, pos: len(expr)}), nil
+}
+
+// node is node of parsed expression.
+type node interface{}
+
+type (
+	numberNode   struct{ value float64 }
+	variableNode struct{ name string }
+	unaryNode    struct{ operand node }
+	binaryNode   struct {
+		op          string
+		left, right node
+	}
+	transposeNode struct{ operand node }
+	indexNode     struct {
+		operand node
+		// indexes contains one index (or slice) for vectors and two
+		// indexes for matrices, high is nil for single index
+		low, high []node
+	}
+	callNode struct {
+		name string
+		args []node
+	}
+)
+
+// parser is recursive descent parser of expressions.
+type parser struct {
+	tokens []token
+	pos    int
+}
+
+// Expr is parsed expression.
+type Expr struct {
+	source string
+	root   node
+}
+
+// Parse parses expression.
+func Parse(expr string) (*Expr, error) {
+	tokens, err := tokenize(expr)
+	if err != nil {
+		return nil, err
+	}
+	p := parser{tokens: tokens}
+	root, err := p.sum()
+	if err != nil {
+		return nil, err
+	}
+	if t := p.peek(); t.kind != tokenEOF {
+		return nil, p.unexpected(t)
+	}
+	return &Expr{source: expr, root: root}, nil
+}
+
+// String returns source of expression.
+func (e *Expr) String() string {
+	return e.source
+}
+
+// peek returns the current token.
+func (p *parser) peek() token {
+	return p.tokens[p.pos]
+}
+
+// accept skips the current token when it is given operator.
+func (p *parser) accept(op string) bool {
+	if t := p.peek(); t.kind == tokenOperator && t.text == op {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+// expect skips given operator or reports error.
+func (p *parser) expect(op string) error {
+	if !p.accept(op) {
+		return p.unexpected(p.peek())
+	}
+	return nil
+}
+
+// unexpected returns error for unexpected token.
+func (p *parser) unexpected(t token) error {
+	if t.kind == tokenEOF {
+		return fmt.Errorf("unexpected end of expression")
+	}
+	return fmt.Errorf("unexpected %q at position %d", t.text, t.pos+1)
+}
+
+// sum parses sum and difference.
+func (p *parser) sum() (node, error) {
+	left, err := p.product()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		op := p.peek().text
+		if p.peek().kind != tokenOperator || op != "+" && op != "-" {
+			return left, nil
+		}
+		p.pos++
+		right, err := p.product()
+		if err != nil {
+			return nil, err
+		}
+		left = binaryNode{op: op, left: left, right: right}
+	}
+}
+
+// product parses products and divisions.
+func (p *parser) product() (node, error) {
+	left, err := p.unary()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		op := p.peek().text
+		if p.peek().kind != tokenOperator || op != "*" && op != ".*" && op != "/" && op != "./" {
+			return left, nil
+		}
+		p.pos++
+		right, err := p.unary()
+		if err != nil {
+			return nil, err
+		}
+		left = binaryNode{op: op, left: left, right: right}
+	}
+}
+
+// unary parses negation.
+func (p *parser) unary() (node, error) {
+	if p.accept("-") {
+		operand, err := p.unary()
+		if err != nil {
+			return nil, err
+		}
+		return unaryNode{operand: operand}, nil
+	}
+	if p.accept("+") {
+		return p.unary()
+	}
+	return p.postfix()
+}
+
+// postfix parses transposition and indexing.
+func (p *parser) postfix() (node, error) {
+	operand, err := p.primary()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		switch {
+		case p.accept("'"):
+			operand = transposeNode{operand: operand}
+		case p.accept("["):
+			index := indexNode{operand: operand}
+			for {
+				low, err := p.sum()
+				if err != nil {
+					return nil, err
+				}
+				var high node
+				if p.accept(":") {
+					if high, err = p.sum(); err != nil {
+						return nil, err
+					}
+				}
+				index.low = append(index.low, low)
+				index.high = append(index.high, high)
+				if !p.accept(",") {
+					break
+				}
+			}
+			if err := p.expect("]"); err != nil {
+				return nil, err
+			}
+			if len(index.low) > 2 {
+				return nil, fmt.Errorf("at most two indexes can be used")
+			}
+			operand = index
+		default:
+			return operand, nil
+		}
+	}
+}
+
+// primary parses numbers, variables, function calls and parenthesized
+// expressions.
+func (p *parser) primary() (node, error) {
+	t := p.peek()
+	switch t.kind {
+	case tokenNumber:
+		p.pos++
+		return numberNode{value: t.value}, nil
+	case tokenIdent:
+		p.pos++
+		if !p.accept("(") {
+			return variableNode{name: t.text}, nil
+		}
+		call := callNode{name: t.text}
+		if p.accept(")") {
+			return call, nil
+		}
+		for {
+			arg, err := p.sum()
+			if err != nil {
+				return nil, err
+			}
+			call.args = append(call.args, arg)
+			if !p.accept(",") {
+				break
+			}
+		}
+		return call, p.expect(")")
+	}
+	if p.accept("(") {
+		inner, err := p.sum()
+		if err != nil {
+			return nil, err
+		}
+		return inner, p.expect(")")
+	}
+	return nil, p.unexpected(t)
+}
+
+// Variables returns names of all variables used in expression, in order of
+// their first use.
+func (e *Expr) Variables() []string {
+	var names []string
+	seen := map[string]bool{}
+	var walk func(n node)
+	walk = func(n node) {
+		switch n := n.(type) {
+		case variableNode:
+			if !seen[n.name] {
+				seen[n.name] = true
+				names = append(names, n.name)
+			}
+		case unaryNode:
+			walk(n.operand)
+		case binaryNode:
+			walk(n.left)
+			walk(n.right)
+		case transposeNode:
+			walk(n.operand)
+		case indexNode:
+			walk(n.operand)
+			for i := range n.low {
+				walk(n.low[i])
+				if n.high[i] != nil {
+					walk(n.high[i])
+				}
+			}
+		case callNode:
+			for _, arg := range n.args {
+				walk(arg)
+			}
+		}
+	}
+	walk(e.root)
+	return names
+}
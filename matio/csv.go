@@ -0,0 +1,96 @@
+/*
+Copyright © 2020 Pavel Tisnovsky
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package matio reads and writes gonum matrices in formats used by other
+// tools: CSV, MatrixMarket (.mtx) and NumPy (.npy and .npz). It allows the
+// examples to load real data instead of hard-coded literals and to
+// exchange matrices with the Python tooling stored in this repository.
+package matio
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+// ReadCSV reads matrix from CSV. When header is true, the first record is
+// treated as column names and returned separately. All other records must
+// have the same number of fields and all fields must be numbers.
+func ReadCSV(r io.Reader, header bool) (*mat.Dense, []string, error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var names []string
+	if header {
+		if len(records) == 0 {
+			return nil, nil, fmt.Errorf("csv: header expected")
+		}
+		names = records[0]
+		records = records[1:]
+	}
+	if len(records) == 0 {
+		return nil, names, fmt.Errorf("csv: no data")
+	}
+
+	rows := len(records)
+	cols := len(records[0])
+	data := make([]float64, 0, rows*cols)
+	for i, record := range records {
+		for j, field := range record {
+			value, err := strconv.ParseFloat(field, 64)
+			if err != nil {
+				return nil, names, fmt.Errorf("csv: record %d, field %d: %w", i+1, j+1, err)
+			}
+			data = append(data, value)
+		}
+	}
+	return mat.NewDense(rows, cols, data), names, nil
+}
+
+// WriteCSV writes matrix into CSV. Header is optional, when it is not
+// nil, its length must be equal to number of columns.
+func WriteCSV(w io.Writer, m mat.Matrix, header []string) error {
+	rows, cols := m.Dims()
+	if header != nil && len(header) != cols {
+		return fmt.Errorf("csv: header has %d columns, matrix has %d", len(header), cols)
+	}
+
+	writer := csv.NewWriter(w)
+	if header != nil {
+		if err := writer.Write(header); err != nil {
+			return err
+		}
+	}
+	record := make([]string, cols)
+	for i := 0; i < rows; i++ {
+		for j := 0; j < cols; j++ {
+			record[j] = strconv.FormatFloat(m.At(i, j), 'g', -1, 64)
+		}
+		if err := writer.Write(record); err != nil {
+			return err
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
@@ -0,0 +1,81 @@
+/*
+Copyright © 2020 Pavel Tisnovsky
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package matio
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+func TestCSVRoundTrip(t *testing.T) {
+	tests := []struct {
+		name   string
+		matrix mat.Matrix
+		header []string
+	}{
+		{"general", mat.NewDense(2, 3, []float64{1, 2, 3, 4.5, -5, 6e10}), nil},
+		{"with header", mat.NewDense(2, 2, []float64{1, 2, 3, 4}), []string{"x", "y, z"}},
+		{"symmetric", mat.NewSymDense(2, []float64{1, 2, 2, 3}), nil},
+		{"skew-symmetric", mat.NewDense(2, 2, []float64{0, -4, 4, 0}), nil},
+		{"sparse", sparseOf(2, 3, Entry{0, 2, 1}, Entry{1, 0, 1}), []string{"a", "b", "c"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			if err := WriteCSV(&buf, tt.matrix, tt.header); err != nil {
+				t.Fatal(err)
+			}
+			m, header, err := ReadCSV(&buf, tt.header != nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !mat.Equal(m, tt.matrix) {
+				t.Errorf("read matrix\n%v\nwant\n%v", mat.Formatted(m), mat.Formatted(tt.matrix))
+			}
+			if !reflect.DeepEqual(header, tt.header) {
+				t.Errorf("header = %q, want %q", header, tt.header)
+			}
+		})
+	}
+}
+
+func TestCSVErrors(t *testing.T) {
+	if err := WriteCSV(&bytes.Buffer{}, mat.NewDense(1, 2, nil), []string{"x"}); err == nil {
+		t.Error("header of wrong length accepted")
+	}
+	tests := []struct {
+		name   string
+		input  string
+		header bool
+	}{
+		{"no data", "x,y\n", true},
+		{"no header", "", true},
+		{"not a number", "1,two\n", false},
+		{"different lengths", "1,2\n3\n", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, _, err := ReadCSV(strings.NewReader(tt.input), tt.header); err == nil {
+				t.Errorf("ReadCSV(%q) succeeded", tt.input)
+			}
+		})
+	}
+}
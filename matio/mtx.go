@@ -0,0 +1,294 @@
+/*
+Copyright © 2020 Pavel Tisnovsky
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package matio
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+// mtxBanner starts every MatrixMarket file.
+const mtxBanner = "%%MatrixMarket"
+
+// mtxHeader contains information stored in the first line of MatrixMarket
+// file, for example "%%MatrixMarket matrix coordinate real symmetric".
+type mtxHeader struct {
+	format   string // coordinate or array
+	field    string // real, integer or pattern
+	symmetry string // general, symmetric or skew-symmetric
+}
+
+// parseHeader parses and validates the banner line.
+func parseHeader(line string) (mtxHeader, error) {
+	fields := strings.Fields(strings.ToLower(line))
+	if len(fields) != 5 || fields[0] != strings.ToLower(mtxBanner) || fields[1] != "matrix" {
+		return mtxHeader{}, fmt.Errorf("mtx: invalid banner %q", line)
+	}
+	h := mtxHeader{format: fields[2], field: fields[3], symmetry: fields[4]}
+
+	switch h.format {
+	case "coordinate", "array":
+	default:
+		return h, fmt.Errorf("mtx: unsupported format %q", h.format)
+	}
+	switch h.field {
+	case "real", "integer", "double":
+	case "pattern":
+		if h.format != "coordinate" {
+			return h, fmt.Errorf("mtx: pattern field requires coordinate format")
+		}
+	default:
+		return h, fmt.Errorf("mtx: unsupported field %q", h.field)
+	}
+	switch h.symmetry {
+	case "general", "symmetric", "skew-symmetric":
+	default:
+		return h, fmt.Errorf("mtx: unsupported symmetry %q", h.symmetry)
+	}
+	return h, nil
+}
+
+// mtxScanner returns non-empty lines that are not comments.
+type mtxScanner struct {
+	*bufio.Scanner
+	line int
+}
+
+func (s *mtxScanner) next() ([]string, error) {
+	for s.Scan() {
+		s.line++
+		text := strings.TrimSpace(s.Text())
+		if text == "" || strings.HasPrefix(text, "%") {
+			continue
+		}
+		return strings.Fields(text), nil
+	}
+	if err := s.Err(); err != nil {
+		return nil, err
+	}
+	return nil, io.ErrUnexpectedEOF
+}
+
+// parseInts converts all fields to integers.
+func parseInts(fields []string) ([]int, error) {
+	values := make([]int, len(fields))
+	for i, field := range fields {
+		value, err := strconv.Atoi(field)
+		if err != nil {
+			return nil, err
+		}
+		values[i] = value
+	}
+	return values, nil
+}
+
+// ReadMatrixMarket reads matrix stored in MatrixMarket exchange format.
+// The concrete type of returned matrix depends on the file header:
+//
+//	array general             *mat.Dense
+//	array symmetric           *mat.SymDense
+//	array skew-symmetric      *mat.Dense
+//	coordinate general        *Sparse
+//	coordinate symmetric      *mat.SymDense
+//	coordinate skew-symmetric *mat.Dense
+//
+// Complex and Hermitian matrices are not supported.
+func ReadMatrixMarket(r io.Reader) (mat.Matrix, error) {
+	s := &mtxScanner{Scanner: bufio.NewScanner(r)}
+	if !s.Scan() {
+		if err := s.Err(); err != nil {
+			return nil, err
+		}
+		return nil, fmt.Errorf("mtx: empty input")
+	}
+	s.line++
+	h, err := parseHeader(s.Text())
+	if err != nil {
+		return nil, err
+	}
+
+	fields, err := s.next()
+	if err != nil {
+		return nil, fmt.Errorf("mtx: size line: %w", err)
+	}
+	size, err := parseInts(fields)
+	if err != nil {
+		return nil, fmt.Errorf("mtx: line %d: %w", s.line, err)
+	}
+
+	if h.format == "array" {
+		if len(size) != 2 {
+			return nil, fmt.Errorf("mtx: line %d: expected rows and columns", s.line)
+		}
+		return readArray(s, h, size[0], size[1])
+	}
+	if len(size) != 3 {
+		return nil, fmt.Errorf("mtx: line %d: expected rows, columns and number of entries", s.line)
+	}
+	return readCoordinate(s, h, size[0], size[1], size[2])
+}
+
+// setter abstracts setting elements of the resulting matrix.
+type setter func(i, j int, v float64)
+
+// newMatrix creates matrix of type given by the header and returns
+// function that sets its elements.
+func newMatrix(h mtxHeader, rows, cols int) (mat.Matrix, setter, error) {
+	if rows <= 0 || cols <= 0 {
+		return nil, nil, fmt.Errorf("mtx: invalid dimensions %dx%d", rows, cols)
+	}
+	if h.symmetry != "general" && rows != cols {
+		return nil, nil, fmt.Errorf("mtx: %s matrix must be square", h.symmetry)
+	}
+
+	switch {
+	case h.symmetry == "symmetric":
+		m := mat.NewSymDense(rows, nil)
+		return m, m.SetSym, nil
+	case h.symmetry == "skew-symmetric":
+		m := mat.NewDense(rows, cols, nil)
+		return m, func(i, j int, v float64) {
+			m.Set(i, j, v)
+			m.Set(j, i, -v)
+		}, nil
+	case h.format == "coordinate":
+		m := NewSparse(rows, cols)
+		return m, m.Set, nil
+	default:
+		m := mat.NewDense(rows, cols, nil)
+		return m, m.Set, nil
+	}
+}
+
+// readArray reads values stored in column-major order. For symmetric
+// matrices only the lower triangle is stored, for skew-symmetric ones
+// the lower triangle without diagonal.
+func readArray(s *mtxScanner, h mtxHeader, rows, cols int) (mat.Matrix, error) {
+	m, set, err := newMatrix(h, rows, cols)
+	if err != nil {
+		return nil, err
+	}
+	for j := 0; j < cols; j++ {
+		first := 0
+		switch h.symmetry {
+		case "symmetric":
+			first = j
+		case "skew-symmetric":
+			first = j + 1
+		}
+		for i := first; i < rows; i++ {
+			fields, err := s.next()
+			if err != nil {
+				return nil, fmt.Errorf("mtx: element (%d, %d): %w", i+1, j+1, err)
+			}
+			value, err := strconv.ParseFloat(fields[0], 64)
+			if err != nil {
+				return nil, fmt.Errorf("mtx: line %d: %w", s.line, err)
+			}
+			set(i, j, value)
+		}
+	}
+	return m, nil
+}
+
+// readCoordinate reads entries in the form "row column [value]" with
+// 1-based indexes.
+func readCoordinate(s *mtxScanner, h mtxHeader, rows, cols, entries int) (mat.Matrix, error) {
+	m, set, err := newMatrix(h, rows, cols)
+	if err != nil {
+		return nil, err
+	}
+	for k := 0; k < entries; k++ {
+		fields, err := s.next()
+		if err != nil {
+			return nil, fmt.Errorf("mtx: entry %d: %w", k+1, err)
+		}
+		if len(fields) < 2 {
+			return nil, fmt.Errorf("mtx: line %d: expected row and column", s.line)
+		}
+		index, err := parseInts(fields[:2])
+		if err != nil {
+			return nil, fmt.Errorf("mtx: line %d: %w", s.line, err)
+		}
+		i, j := index[0]-1, index[1]-1
+		if i < 0 || i >= rows || j < 0 || j >= cols {
+			return nil, fmt.Errorf("mtx: line %d: index (%d, %d) out of range", s.line, i+1, j+1)
+		}
+
+		value := 1.0
+		if h.field != "pattern" {
+			if len(fields) < 3 {
+				return nil, fmt.Errorf("mtx: line %d: value expected", s.line)
+			}
+			value, err = strconv.ParseFloat(fields[2], 64)
+			if err != nil {
+				return nil, fmt.Errorf("mtx: line %d: %w", s.line, err)
+			}
+		}
+		// diagonal of skew-symmetric matrix is zero by definition
+		if h.symmetry == "skew-symmetric" && i == j && value != 0 {
+			return nil, fmt.Errorf("mtx: line %d: nonzero diagonal entry of skew-symmetric matrix", s.line)
+		}
+		set(i, j, value)
+	}
+	return m, nil
+}
+
+// WriteMatrixMarket writes matrix in MatrixMarket exchange format. Sparse
+// matrices are written in coordinate format, symmetric matrices (i.e.
+// values implementing mat.Symmetric such as *mat.SymDense) as array with
+// the lower triangle only and all other matrices as general array.
+func WriteMatrixMarket(w io.Writer, m mat.Matrix) error {
+	bw := bufio.NewWriter(w)
+	rows, cols := m.Dims()
+	format := func(v float64) string {
+		return strconv.FormatFloat(v, 'g', -1, 64)
+	}
+
+	switch x := m.(type) {
+	case *Sparse:
+		entries := x.Entries()
+		fmt.Fprintf(bw, "%s matrix coordinate real general\n", mtxBanner)
+		fmt.Fprintf(bw, "%d %d %d\n", rows, cols, len(entries))
+		for _, e := range entries {
+			fmt.Fprintf(bw, "%d %d %s\n", e.Row+1, e.Col+1, format(e.Value))
+		}
+	case mat.Symmetric:
+		n := x.SymmetricDim()
+		fmt.Fprintf(bw, "%s matrix array real symmetric\n", mtxBanner)
+		fmt.Fprintf(bw, "%d %d\n", n, n)
+		for j := 0; j < n; j++ {
+			for i := j; i < n; i++ {
+				fmt.Fprintln(bw, format(x.At(i, j)))
+			}
+		}
+	default:
+		fmt.Fprintf(bw, "%s matrix array real general\n", mtxBanner)
+		fmt.Fprintf(bw, "%d %d\n", rows, cols)
+		for j := 0; j < cols; j++ {
+			for i := 0; i < rows; i++ {
+				fmt.Fprintln(bw, format(m.At(i, j)))
+			}
+		}
+	}
+	return bw.Flush()
+}
@@ -0,0 +1,200 @@
+/*
+Copyright © 2020 Pavel Tisnovsky
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package matio
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+// sparseOf returns sparse matrix with given nonzero values.
+func sparseOf(rows, cols int, entries ...Entry) *Sparse {
+	s := NewSparse(rows, cols)
+	for _, e := range entries {
+		s.Set(e.Row, e.Col, e.Value)
+	}
+	return s
+}
+
+func TestMatrixMarketRoundTrip(t *testing.T) {
+	tests := []struct {
+		name   string
+		matrix mat.Matrix
+		header string
+	}{
+		{
+			name:   "general",
+			matrix: mat.NewDense(2, 3, []float64{1, 2, 3, 4.5, -5, 6e10}),
+			header: "%%MatrixMarket matrix array real general",
+		},
+		{
+			name:   "symmetric",
+			matrix: mat.NewSymDense(3, []float64{1, 2, 3, 2, 4, 5, 3, 5, 6}),
+			header: "%%MatrixMarket matrix array real symmetric",
+		},
+		{
+			name:   "sparse",
+			matrix: sparseOf(3, 4, Entry{0, 0, 1}, Entry{2, 3, -2.5}, Entry{1, 2, 3}),
+			header: "%%MatrixMarket matrix coordinate real general",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			if err := WriteMatrixMarket(&buf, tt.matrix); err != nil {
+				t.Fatal(err)
+			}
+			if header, _, _ := strings.Cut(buf.String(), "\n"); header != tt.header {
+				t.Errorf("header = %q, want %q", header, tt.header)
+			}
+			m, err := ReadMatrixMarket(&buf)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !mat.Equal(m, tt.matrix) {
+				t.Errorf("read matrix\n%v\nwant\n%v", mat.Formatted(m), mat.Formatted(tt.matrix))
+			}
+		})
+	}
+}
+
+func TestReadMatrixMarket(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  mat.Matrix
+	}{
+		{
+			name: "array symmetric",
+			input: `%%MatrixMarket matrix array real symmetric
+2 2
+1
+2
+3
+`,
+			want: mat.NewDense(2, 2, []float64{1, 2, 2, 3}),
+		},
+		{
+			name: "array skew-symmetric",
+			input: `%%MatrixMarket matrix array real skew-symmetric
+3 3
+1
+2
+3
+`,
+			want: mat.NewDense(3, 3, []float64{0, -1, -2, 1, 0, -3, 2, 3, 0}),
+		},
+		{
+			name: "coordinate symmetric",
+			input: `%%MatrixMarket matrix coordinate integer symmetric
+% comment
+2 2 2
+1 1 5
+2 1 7
+`,
+			want: mat.NewDense(2, 2, []float64{5, 7, 7, 0}),
+		},
+		{
+			name: "coordinate skew-symmetric",
+			input: `%%MatrixMarket matrix coordinate real skew-symmetric
+2 2 2
+1 1 0
+2 1 4
+`,
+			want: mat.NewDense(2, 2, []float64{0, -4, 4, 0}),
+		},
+		{
+			name: "coordinate pattern",
+			input: `%%MatrixMarket matrix coordinate pattern general
+2 3 2
+1 3
+2 1
+`,
+			want: mat.NewDense(2, 3, []float64{0, 0, 1, 1, 0, 0}),
+		},
+		{
+			name: "coordinate pattern symmetric",
+			input: `%%MatrixMarket matrix coordinate pattern symmetric
+2 2 1
+2 1
+`,
+			want: mat.NewDense(2, 2, []float64{0, 1, 1, 0}),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := ReadMatrixMarket(strings.NewReader(tt.input))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !mat.Equal(m, tt.want) {
+				t.Errorf("read matrix\n%v\nwant\n%v", mat.Formatted(m), mat.Formatted(tt.want))
+			}
+
+			// the matrix is written in general or symmetric form
+			var buf bytes.Buffer
+			if err := WriteMatrixMarket(&buf, m); err != nil {
+				t.Fatal(err)
+			}
+			back, err := ReadMatrixMarket(&buf)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !mat.Equal(back, tt.want) {
+				t.Errorf("matrix after round trip\n%v\nwant\n%v", mat.Formatted(back), mat.Formatted(tt.want))
+			}
+		})
+	}
+}
+
+func TestReadMatrixMarketErrors(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{"empty", "", "empty input"},
+		{"banner", "%%MatrixMarket vector array real general\n", "invalid banner"},
+		{"complex", "%%MatrixMarket matrix array complex general\n", "unsupported field"},
+		{"pattern array", "%%MatrixMarket matrix array pattern general\n", "requires coordinate"},
+		{"hermitian", "%%MatrixMarket matrix array real hermitian\n", "unsupported symmetry"},
+		{"not square", "%%MatrixMarket matrix array real symmetric\n2 3\n", "must be square"},
+		{"missing value", "%%MatrixMarket matrix array real general\n2 1\n1\n", "element (2, 1)"},
+		{"out of range", "%%MatrixMarket matrix coordinate real general\n2 2 1\n3 1 1\n", "out of range"},
+		{
+			"skew-symmetric diagonal",
+			"%%MatrixMarket matrix coordinate real skew-symmetric\n2 2 1\n2 2 1.5\n",
+			"nonzero diagonal entry",
+		},
+		{
+			"skew-symmetric pattern diagonal",
+			"%%MatrixMarket matrix coordinate pattern skew-symmetric\n2 2 1\n1 1\n",
+			"nonzero diagonal entry",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ReadMatrixMarket(strings.NewReader(tt.input))
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("error = %v, want error containing %q", err, tt.want)
+			}
+		})
+	}
+}
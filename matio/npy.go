@@ -0,0 +1,299 @@
+/*
+Copyright © 2020 Pavel Tisnovsky
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package matio
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+// npyMagic starts every .npy file, it is followed by major and minor
+// version of the format.
+const npyMagic = "\x93NUMPY"
+
+// npyHeader contains information stored in the header dictionary, for
+// example {'descr': '<f8', 'fortran_order': False, 'shape': (3, 4), }
+type npyHeader struct {
+	descr        string
+	fortranOrder bool
+	shape        []int
+}
+
+var (
+	descrRegexp   = regexp.MustCompile(`'descr'\s*:\s*'([^']*)'`)
+	fortranRegexp = regexp.MustCompile(`'fortran_order'\s*:\s*(True|False)`)
+	shapeRegexp   = regexp.MustCompile(`'shape'\s*:\s*\(([^)]*)\)`)
+)
+
+// parseNpyHeader parses the header dictionary written in Python syntax.
+func parseNpyHeader(header string) (npyHeader, error) {
+	var h npyHeader
+
+	match := descrRegexp.FindStringSubmatch(header)
+	if match == nil {
+		return h, fmt.Errorf("npy: descr not found in header %q", header)
+	}
+	h.descr = match[1]
+
+	match = fortranRegexp.FindStringSubmatch(header)
+	if match == nil {
+		return h, fmt.Errorf("npy: fortran_order not found in header %q", header)
+	}
+	h.fortranOrder = match[1] == "True"
+
+	match = shapeRegexp.FindStringSubmatch(header)
+	if match == nil {
+		return h, fmt.Errorf("npy: shape not found in header %q", header)
+	}
+	for _, dim := range strings.Split(match[1], ",") {
+		dim = strings.TrimSpace(dim)
+		if dim == "" {
+			continue
+		}
+		value, err := strconv.Atoi(dim)
+		if err != nil {
+			return h, fmt.Errorf("npy: invalid shape %q", match[1])
+		}
+		h.shape = append(h.shape, value)
+	}
+	return h, nil
+}
+
+// decoder converts raw bytes of one element to float64.
+type decoder struct {
+	size   int
+	decode func([]byte) float64
+}
+
+// newDecoder returns decoder for given NumPy type description such as
+// "<f8" (little endian float64) or ">i4" (big endian int32).
+func newDecoder(descr string) (decoder, error) {
+	if len(descr) < 3 {
+		return decoder{}, fmt.Errorf("npy: unsupported dtype %q", descr)
+	}
+	var order binary.ByteOrder = binary.LittleEndian
+	switch descr[0] {
+	case '<', '|', '=':
+	case '>':
+		order = binary.BigEndian
+	default:
+		return decoder{}, fmt.Errorf("npy: unsupported byte order in dtype %q", descr)
+	}
+
+	switch descr[1:] {
+	case "f8":
+		return decoder{8, func(b []byte) float64 { return math.Float64frombits(order.Uint64(b)) }}, nil
+	case "f4":
+		return decoder{4, func(b []byte) float64 { return float64(math.Float32frombits(order.Uint32(b))) }}, nil
+	case "i8":
+		return decoder{8, func(b []byte) float64 { return float64(int64(order.Uint64(b))) }}, nil
+	case "i4":
+		return decoder{4, func(b []byte) float64 { return float64(int32(order.Uint32(b))) }}, nil
+	case "i2":
+		return decoder{2, func(b []byte) float64 { return float64(int16(order.Uint16(b))) }}, nil
+	case "i1":
+		return decoder{1, func(b []byte) float64 { return float64(int8(b[0])) }}, nil
+	case "u8":
+		return decoder{8, func(b []byte) float64 { return float64(order.Uint64(b)) }}, nil
+	case "u4":
+		return decoder{4, func(b []byte) float64 { return float64(order.Uint32(b)) }}, nil
+	case "u2":
+		return decoder{2, func(b []byte) float64 { return float64(order.Uint16(b)) }}, nil
+	case "u1", "b1":
+		return decoder{1, func(b []byte) float64 { return float64(b[0]) }}, nil
+	default:
+		return decoder{}, fmt.Errorf("npy: unsupported dtype %q", descr)
+	}
+}
+
+// ReadNPY reads array stored in NumPy .npy format. One dimensional arrays
+// are returned as *mat.VecDense, two dimensional arrays as *mat.Dense.
+// Values of all numeric types are converted to float64.
+func ReadNPY(r io.Reader) (mat.Matrix, error) {
+	prefix := make([]byte, len(npyMagic)+2)
+	if _, err := io.ReadFull(r, prefix); err != nil {
+		return nil, fmt.Errorf("npy: %w", err)
+	}
+	if string(prefix[:len(npyMagic)]) != npyMagic {
+		return nil, fmt.Errorf("npy: invalid magic string")
+	}
+
+	var headerLen int
+	switch major := prefix[len(npyMagic)]; major {
+	case 1:
+		var n uint16
+		if err := binary.Read(r, binary.LittleEndian, &n); err != nil {
+			return nil, fmt.Errorf("npy: %w", err)
+		}
+		headerLen = int(n)
+	case 2, 3:
+		var n uint32
+		if err := binary.Read(r, binary.LittleEndian, &n); err != nil {
+			return nil, fmt.Errorf("npy: %w", err)
+		}
+		headerLen = int(n)
+	default:
+		return nil, fmt.Errorf("npy: unsupported format version %d", major)
+	}
+
+	header := make([]byte, headerLen)
+	if _, err := io.ReadFull(r, header); err != nil {
+		return nil, fmt.Errorf("npy: %w", err)
+	}
+	h, err := parseNpyHeader(string(header))
+	if err != nil {
+		return nil, err
+	}
+	dec, err := newDecoder(h.descr)
+	if err != nil {
+		return nil, err
+	}
+
+	var rows, cols int
+	switch len(h.shape) {
+	case 1:
+		rows, cols = h.shape[0], 1
+	case 2:
+		rows, cols = h.shape[0], h.shape[1]
+	default:
+		return nil, fmt.Errorf("npy: only 1D and 2D arrays are supported, got shape %v", h.shape)
+	}
+	if rows <= 0 || cols <= 0 {
+		return nil, fmt.Errorf("npy: empty array with shape %v", h.shape)
+	}
+
+	raw := make([]byte, rows*cols*dec.size)
+	if _, err := io.ReadFull(r, raw); err != nil {
+		return nil, fmt.Errorf("npy: %w", err)
+	}
+	data := make([]float64, rows*cols)
+	for i := range data {
+		data[i] = dec.decode(raw[i*dec.size : (i+1)*dec.size])
+	}
+
+	if len(h.shape) == 1 {
+		return mat.NewVecDense(rows, data), nil
+	}
+	if h.fortranOrder {
+		// data are stored column by column
+		m := mat.NewDense(cols, rows, data)
+		return mat.DenseCopyOf(m.T()), nil
+	}
+	return mat.NewDense(rows, cols, data), nil
+}
+
+// WriteNPY writes matrix in NumPy .npy format (version 1.0) as array of
+// little endian float64 values. Vectors are written as one dimensional
+// arrays.
+func WriteNPY(w io.Writer, m mat.Matrix) error {
+	rows, cols := m.Dims()
+	shape := fmt.Sprintf("(%d, %d)", rows, cols)
+	if _, ok := m.(mat.Vector); ok && cols == 1 {
+		shape = fmt.Sprintf("(%d,)", rows)
+	}
+	header := fmt.Sprintf("{'descr': '<f8', 'fortran_order': False, 'shape': %s, }", shape)
+
+	// header is padded by spaces and terminated by new line so the data
+	// are aligned to 64 bytes
+	total := len(npyMagic) + 2 + 2 + len(header) + 1
+	if padding := total % 64; padding != 0 {
+		header += strings.Repeat(" ", 64-padding)
+	}
+	header += "\n"
+
+	var buf bytes.Buffer
+	buf.WriteString(npyMagic)
+	buf.Write([]byte{1, 0})
+	binary.Write(&buf, binary.LittleEndian, uint16(len(header)))
+	buf.WriteString(header)
+
+	value := make([]byte, 8)
+	for i := 0; i < rows; i++ {
+		for j := 0; j < cols; j++ {
+			binary.LittleEndian.PutUint64(value, math.Float64bits(m.At(i, j)))
+			buf.Write(value)
+		}
+	}
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+// ReadNPZ reads all arrays stored in NumPy .npz archive. Keys of returned
+// map are names of arrays without the .npy suffix. Compressed archives
+// written by numpy.savez_compressed are supported too.
+func ReadNPZ(r io.ReaderAt, size int64) (map[string]mat.Matrix, error) {
+	archive, err := zip.NewReader(r, size)
+	if err != nil {
+		return nil, fmt.Errorf("npz: %w", err)
+	}
+
+	arrays := map[string]mat.Matrix{}
+	for _, file := range archive.File {
+		if !strings.HasSuffix(file.Name, ".npy") {
+			continue
+		}
+		f, err := file.Open()
+		if err != nil {
+			return nil, fmt.Errorf("npz: %s: %w", file.Name, err)
+		}
+		m, err := ReadNPY(f)
+		f.Close()
+		if err != nil {
+			return nil, fmt.Errorf("npz: %s: %w", file.Name, err)
+		}
+		arrays[strings.TrimSuffix(file.Name, ".npy")] = m
+	}
+	return arrays, nil
+}
+
+// WriteNPZ writes matrices into NumPy .npz archive, the archive can be
+// read by numpy.load. When compress is true, arrays are deflated the same
+// way as by numpy.savez_compressed.
+func WriteNPZ(w io.Writer, arrays map[string]mat.Matrix, compress bool) error {
+	names := make([]string, 0, len(arrays))
+	for name := range arrays {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	method := zip.Store
+	if compress {
+		method = zip.Deflate
+	}
+
+	archive := zip.NewWriter(w)
+	for _, name := range names {
+		f, err := archive.CreateHeader(&zip.FileHeader{Name: name + ".npy", Method: method})
+		if err != nil {
+			return fmt.Errorf("npz: %w", err)
+		}
+		if err := WriteNPY(f, arrays[name]); err != nil {
+			return fmt.Errorf("npz: %s: %w", name, err)
+		}
+	}
+	return archive.Close()
+}
@@ -0,0 +1,109 @@
+/*
+Copyright © 2020 Pavel Tisnovsky
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package matio
+
+import (
+	"bytes"
+	"math"
+	"testing"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+func TestNPYRoundTrip(t *testing.T) {
+	tests := []struct {
+		name   string
+		matrix mat.Matrix
+	}{
+		{"general", mat.NewDense(2, 3, []float64{1, 2, 3, 4.5, -5, 6e10})},
+		{"symmetric", mat.NewSymDense(2, []float64{1, 2, 2, 3})},
+		{"skew-symmetric", mat.NewDense(2, 2, []float64{0, -4, 4, 0})},
+		{"sparse", sparseOf(2, 3, Entry{0, 2, 1}, Entry{1, 0, 1})},
+		{"vector", mat.NewVecDense(3, []float64{1, math.Inf(-1), 3})},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			if err := WriteNPY(&buf, tt.matrix); err != nil {
+				t.Fatal(err)
+			}
+			// data are aligned to 64 bytes
+			rows, cols := tt.matrix.Dims()
+			if n := buf.Len() - rows*cols*8; n%64 != 0 {
+				t.Errorf("data start at offset %d", n)
+			}
+			m, err := ReadNPY(&buf)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !mat.Equal(m, tt.matrix) {
+				t.Errorf("read matrix\n%v\nwant\n%v", mat.Formatted(m), mat.Formatted(tt.matrix))
+			}
+			_, isVector := m.(*mat.VecDense)
+			_, wantVector := tt.matrix.(mat.Vector)
+			if isVector != wantVector {
+				t.Errorf("read %T from %T", m, tt.matrix)
+			}
+		})
+	}
+}
+
+func TestNPZRoundTrip(t *testing.T) {
+	arrays := map[string]mat.Matrix{
+		"a": mat.NewDense(2, 2, []float64{1, 2, 3, 4}),
+		"b": mat.NewSymDense(2, []float64{1, 5, 5, 3}),
+		"v": mat.NewVecDense(2, []float64{-1, 1}),
+	}
+	for _, compress := range []bool{false, true} {
+		var buf bytes.Buffer
+		if err := WriteNPZ(&buf, arrays, compress); err != nil {
+			t.Fatal(err)
+		}
+		read, err := ReadNPZ(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(read) != len(arrays) {
+			t.Errorf("compress=%v: read %d arrays, want %d", compress, len(read), len(arrays))
+		}
+		for name, want := range arrays {
+			if m, ok := read[name]; !ok || !mat.Equal(m, want) {
+				t.Errorf("compress=%v: array %s = %v, want %v", compress, name, m, want)
+			}
+		}
+	}
+}
+
+func TestReadNPYFortranOrder(t *testing.T) {
+	header := "{'descr': '<i4', 'fortran_order': True, 'shape': (2, 3), }"
+	var buf bytes.Buffer
+	buf.WriteString(npyMagic)
+	buf.Write([]byte{1, 0, byte(len(header) + 1), 0})
+	buf.WriteString(header + "\n")
+	for _, v := range []int32{1, 4, 2, 5, 3, 6} {
+		buf.Write([]byte{byte(v), 0, 0, 0})
+	}
+
+	m, err := ReadNPY(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := mat.NewDense(2, 3, []float64{1, 2, 3, 4, 5, 6})
+	if !mat.Equal(m, want) {
+		t.Errorf("read matrix\n%v\nwant\n%v", mat.Formatted(m), mat.Formatted(want))
+	}
+}
@@ -0,0 +1,107 @@
+/*
+Copyright © 2020 Pavel Tisnovsky
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package matio
+
+import (
+	"sort"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+// Entry is one non-zero element of sparse matrix.
+type Entry struct {
+	Row   int
+	Col   int
+	Value float64
+}
+
+// Sparse is a simple sparse matrix in coordinate (COO) format. The gonum
+// mat package does not provide sparse matrices, so this type is returned
+// when MatrixMarket coordinate file with general symmetry is read. It
+// implements mat.Matrix, so it can be used as operand of all gonum
+// operations and it can be converted to Dense by mat.DenseCopyOf.
+type Sparse struct {
+	rows    int
+	cols    int
+	entries map[[2]int]float64
+}
+
+// NewSparse creates empty sparse matrix with given dimensions.
+func NewSparse(rows, cols int) *Sparse {
+	if rows <= 0 || cols <= 0 {
+		panic(mat.ErrZeroLength)
+	}
+	return &Sparse{rows: rows, cols: cols, entries: map[[2]int]float64{}}
+}
+
+// Dims returns number of rows and columns of the matrix.
+func (s *Sparse) Dims() (r, c int) {
+	return s.rows, s.cols
+}
+
+// At returns value of element at row i and column j.
+func (s *Sparse) At(i, j int) float64 {
+	if i < 0 || i >= s.rows {
+		panic(mat.ErrRowAccess)
+	}
+	if j < 0 || j >= s.cols {
+		panic(mat.ErrColAccess)
+	}
+	return s.entries[[2]int{i, j}]
+}
+
+// T returns transposed matrix.
+func (s *Sparse) T() mat.Matrix {
+	return mat.Transpose{Matrix: s}
+}
+
+// Set sets value of element at row i and column j. Setting zero removes
+// the element from the matrix.
+func (s *Sparse) Set(i, j int, v float64) {
+	if i < 0 || i >= s.rows {
+		panic(mat.ErrRowAccess)
+	}
+	if j < 0 || j >= s.cols {
+		panic(mat.ErrColAccess)
+	}
+	if v == 0 {
+		delete(s.entries, [2]int{i, j})
+		return
+	}
+	s.entries[[2]int{i, j}] = v
+}
+
+// NNZ returns number of non-zero elements.
+func (s *Sparse) NNZ() int {
+	return len(s.entries)
+}
+
+// Entries returns all non-zero elements sorted by column and then by row,
+// i.e. in the order used by MatrixMarket files written by other tools.
+func (s *Sparse) Entries() []Entry {
+	entries := make([]Entry, 0, len(s.entries))
+	for k, v := range s.entries {
+		entries = append(entries, Entry{Row: k[0], Col: k[1], Value: v})
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		if entries[i].Col != entries[j].Col {
+			return entries[i].Col < entries[j].Col
+		}
+		return entries[i].Row < entries[j].Row
+	})
+	return entries
+}
@@ -0,0 +1,323 @@
+/*
+Copyright © 2020 Pavel Tisnovsky
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notebook
+
+import (
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/scanner"
+	"go/token"
+	"sort"
+	"strings"
+
+	"github.com/tisnik/literate-programming-examples/literate"
+)
+
+// program collects parts of Go program assembled from notebook cells.
+type program struct {
+	options Options
+	// header is prose written before the first code cell, footer is
+	// prose written after the last one
+	header []string
+	footer []string
+	// imports contains import specs, e.g. "fmt" or m "math"
+	imports []string
+	decls   []string
+	body    []string
+	// prose has not been written yet, it belongs to the next code
+	prose   []string
+	code    bool
+	usesFmt bool
+}
+
+// Assemble converts Go notebook that has not been exported from literate
+// source into one program. Imports from all cells are merged, functions
+// and types are declared at the top level and all other code is placed
+// into function main in order of cells. Values of expressions displayed by
+// the notebook kernel are printed by fmt.Println, as expressions can not
+// be used as statements in Go. Markdown becomes prose in comments and
+// outputs stored in notebook become expected outputs.
+func Assemble(nb *Notebook, options Options) ([]byte, error) {
+	p := program{options: options}
+	for i, cell := range nb.Cells {
+		var err error
+		switch cell.Type {
+		case Markdown:
+			p.markdown(cell)
+		case Code:
+			err = p.cell(cell)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("cell %d: %w", i+1, err)
+		}
+	}
+	if p.code {
+		p.footer = p.prose
+	} else {
+		p.header = p.prose
+	}
+
+	src := p.source()
+	formatted, err := format.Source(src)
+	if err != nil {
+		return src, fmt.Errorf("assembled program is not valid: %w", err)
+	}
+	return formatted, nil
+}
+
+// markdown adds prose, cells are separated by empty line.
+func (p *program) markdown(cell Cell) {
+	if len(p.prose) > 0 {
+		p.prose = append(p.prose, "")
+	}
+	p.prose = append(p.prose, proseLines(literate.Go, cell.Source.Lines(), "", "")...)
+}
+
+// cell adds code of one cell to declarations or body of main, together
+// with prose written before the cell and outputs of the cell.
+func (p *program) cell(cell Cell) error {
+	if !p.code {
+		// prose written before any code describes the whole program
+		p.header, p.prose = p.prose, nil
+		p.code = true
+	}
+
+	imports, rest := splitImports(cell.Source.Lines())
+	p.imports = append(p.imports, imports...)
+	if strings.TrimSpace(strings.Join(rest, "")) == "" && len(cell.Outputs) == 0 {
+		// prose belongs to the next cell
+		return nil
+	}
+	code := strings.Join(rest, "\n")
+
+	if isDeclaration(code) {
+		p.decls = append(p.decls, "")
+		p.decls = append(p.decls, p.prose...)
+		p.decls = append(p.decls, rest...)
+		p.decls = append(p.decls, p.outputs(cell, "")...)
+		p.prose = nil
+		return nil
+	}
+
+	statements, printed, err := printValues(code, hasResult(cell))
+	if err != nil {
+		return err
+	}
+	p.usesFmt = p.usesFmt || printed
+	if len(p.body) > 0 {
+		p.body = append(p.body, "")
+	}
+	for _, line := range p.prose {
+		if line != "" {
+			line = "\t" + line
+		}
+		p.body = append(p.body, line)
+	}
+	p.body = append(p.body, indent(statements)...)
+	p.body = append(p.body, p.outputs(cell, "\t")...)
+	p.prose = nil
+	return nil
+}
+
+// outputs converts outputs of cell into expected output.
+func (p *program) outputs(cell Cell, indent string) []string {
+	var lines []string
+	for _, output := range cell.Outputs {
+		if text := output.PlainText(); text != "" {
+			o := OutputLayout{Style: p.options.Style, Indent: indent}
+			lines = append(lines, outputLines(literate.Go, textLines(text), o)...)
+		}
+	}
+	return lines
+}
+
+// source returns source of the whole program.
+func (p *program) source() []byte {
+	var lines []string
+	if len(p.header) > 0 {
+		lines = append(lines, p.header...)
+		lines = append(lines, "")
+	}
+	lines = append(lines, "package main", "")
+
+	imports := p.imports
+	if p.usesFmt {
+		imports = append(imports, `"fmt"`)
+	}
+	if specs := uniqueImports(imports); len(specs) > 0 {
+		lines = append(lines, "import (")
+		for _, spec := range specs {
+			lines = append(lines, "\t"+spec)
+		}
+		lines = append(lines, ")")
+	}
+	lines = append(lines, p.decls...)
+	lines = append(lines, "", "func main() {")
+	lines = append(lines, p.body...)
+	lines = append(lines, "}")
+	if len(p.footer) > 0 {
+		lines = append(lines, "")
+		lines = append(lines, p.footer...)
+	}
+	return []byte(strings.Join(lines, "\n") + "\n")
+}
+
+// splitImports separates import declarations written at the beginning of
+// cell from the rest of code.
+func splitImports(lines []string) ([]string, []string) {
+	var imports []string
+	inGroup := false
+	for i, line := range lines {
+		trimmed := strings.TrimSpace(line)
+		switch {
+		case inGroup && trimmed == ")":
+			inGroup = false
+		case inGroup:
+			if trimmed != "" && !strings.HasPrefix(trimmed, "//") {
+				imports = append(imports, trimmed)
+			}
+		case trimmed == "import (":
+			inGroup = true
+		case strings.HasPrefix(trimmed, "import "):
+			imports = append(imports, strings.TrimSpace(strings.TrimPrefix(trimmed, "import ")))
+		case trimmed == "" || strings.HasPrefix(trimmed, "//"):
+			// empty lines and comments before imports are skipped
+		default:
+			return imports, lines[i:]
+		}
+	}
+	return imports, nil
+}
+
+// uniqueImports returns sorted import specs without duplicates.
+func uniqueImports(imports []string) []string {
+	found := map[string]bool{}
+	var specs []string
+	for _, spec := range imports {
+		if !found[spec] {
+			found[spec] = true
+			specs = append(specs, spec)
+		}
+	}
+	sort.Strings(specs)
+	return specs
+}
+
+// isDeclaration checks whether code contains only declarations of
+// functions and types. Variables and constants are declared in main, as
+// their initialization can depend on the preceding statements.
+func isDeclaration(code string) bool {
+	f, err := parser.ParseFile(token.NewFileSet(), "", "package main\n"+code, 0)
+	if err != nil || len(f.Decls) == 0 {
+		return false
+	}
+	for _, decl := range f.Decls {
+		if d, ok := decl.(*ast.GenDecl); ok && d.Tok != token.TYPE {
+			return false
+		}
+	}
+	return true
+}
+
+// hasResult checks whether the cell displayed value of expression.
+func hasResult(cell Cell) bool {
+	for _, output := range cell.Outputs {
+		if output.Type == "execute_result" {
+			return true
+		}
+	}
+	return false
+}
+
+// mainHeader is prepended to statements so they can be parsed.
+const mainHeader = "package main\nfunc main() {\n"
+
+// printValues parses statements and wraps expressions, whose value would be
+// displayed by the kernel, into fmt.Println. It returns the modified code
+// and whether any expression has been wrapped.
+func printValues(code string, result bool) (string, bool, error) {
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, "", mainHeader+code+"\n}\n", 0)
+	if err != nil {
+		return "", false, err
+	}
+	list := f.Decls[0].(*ast.FuncDecl).Body.List
+
+	type span struct{ from, to int }
+	var spans []span
+	for i, stmt := range list {
+		expr, ok := stmt.(*ast.ExprStmt)
+		if !ok {
+			continue
+		}
+		call, isCall := expr.X.(*ast.CallExpr)
+		last := i == len(list)-1
+		if isCall && !(last && result && !isPrint(call)) {
+			continue
+		}
+		spans = append(spans, span{
+			from: fset.Position(expr.X.Pos()).Offset - len(mainHeader),
+			to:   fset.Position(expr.X.End()).Offset - len(mainHeader),
+		})
+	}
+	for i := len(spans) - 1; i >= 0; i-- {
+		s := spans[i]
+		code = code[:s.from] + "fmt.Println(" + code[s.from:s.to] + ")" + code[s.to:]
+	}
+	return code, len(spans) > 0, nil
+}
+
+// isPrint checks whether function called prints its arguments.
+func isPrint(call *ast.CallExpr) bool {
+	selector, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok {
+		return false
+	}
+	pkg, ok := selector.X.(*ast.Ident)
+	return ok && pkg.Name == "fmt" && strings.HasPrefix(selector.Sel.Name, "Print")
+}
+
+// indent indents code by one tab, content of raw strings is not changed.
+func indent(code string) []string {
+	continuation := map[int]bool{}
+	var s scanner.Scanner
+	fset := token.NewFileSet()
+	file := fset.AddFile("", fset.Base(), len(code))
+	s.Init(file, []byte(code), nil, 0)
+	for {
+		pos, tok, lit := s.Scan()
+		if tok == token.EOF {
+			break
+		}
+		if tok == token.STRING && strings.HasPrefix(lit, "`") {
+			first := fset.Position(pos).Line
+			for line := first + 1; line <= first+strings.Count(lit, "\n"); line++ {
+				continuation[line] = true
+			}
+		}
+	}
+
+	lines := strings.Split(code, "\n")
+	for i, line := range lines {
+		if line != "" && !continuation[i+1] {
+			lines[i] = "\t" + line
+		}
+	}
+	return lines
+}
@@ -0,0 +1,348 @@
+/*
+Copyright © 2020 Pavel Tisnovsky
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notebook
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/tisnik/literate-programming-examples/literate"
+)
+
+// OutputKind is kind of Markdown cell that contains expected output not
+// following any code.
+const OutputKind = "output"
+
+// metadataOf returns notebook metadata for given language, Go notebooks
+// are run by the gophernotes kernel.
+func metadataOf(language literate.Language) Metadata {
+	if language.Name == literate.Python.Name {
+		return Metadata{
+			Kernelspec:   &Kernelspec{DisplayName: "Python 3", Language: "python", Name: "python3"},
+			LanguageInfo: &LanguageInfo{FileExtension: ".py", MimeType: "text/x-python", Name: "python"},
+		}
+	}
+	return Metadata{
+		Kernelspec:   &Kernelspec{DisplayName: "Go", Language: "go", Name: "gophernotes"},
+		LanguageInfo: &LanguageInfo{FileExtension: ".go", MimeType: "text/x-go", Name: "go"},
+	}
+}
+
+// exporter converts blocks of literate source into cells.
+type exporter struct {
+	lines    []string
+	language literate.Language
+	nb       *Notebook
+	// pos is index of the first line not converted yet, pending contains
+	// lines between blocks that have not been assigned to any cell.
+	pos     int
+	pending []string
+	inMain  bool
+}
+
+// Export converts literate source into notebook. Each paragraph of prose
+// becomes one Markdown cell and each run of code becomes one code cell,
+// expected output is stored as output of the preceding code cell.
+// Declaration of package, header of function main and its closing brace
+// can not be run by the notebook kernel, so they are stored in raw cells.
+func Export(filename string, src []byte) (*Notebook, error) {
+	doc, err := literate.Parse(filename, src)
+	if err != nil {
+		return nil, err
+	}
+	lines := strings.Split(string(src), "\n")
+	if lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+
+	metadata := metadataOf(doc.Language)
+	metadata.Literate = &Document{Source: filepath.Base(filename), Language: doc.Language.Name}
+	e := exporter{
+		lines:    lines,
+		language: doc.Language,
+		nb:       &Notebook{Metadata: metadata, Format: 4, Minor: 4, Cells: []Cell{}},
+	}
+	for _, b := range doc.Blocks {
+		var err error
+		switch b.Kind {
+		case literate.Prose:
+			e.prose(b)
+		case literate.Code:
+			e.code(b)
+		case literate.Output:
+			err = e.output(b)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%s:%d: %w", filename, b.Start, err)
+		}
+	}
+	e.skip(len(lines))
+	e.assign()
+
+	// layout is not stored for cells written in the default way
+	for i := range e.nb.Cells {
+		if l := e.nb.Cells[i].Metadata.Literate; l != nil && isDefault(*l) {
+			e.nb.Cells[i].Metadata.Literate = nil
+		}
+	}
+	return e.nb, nil
+}
+
+// isDefault checks whether layout contains any information.
+func isDefault(l Layout) bool {
+	return l.Kind == "" && l.Indent == "" && l.Lang == "" && l.Style == "" &&
+		!l.Verbatim && len(l.Outputs) == 0 && len(l.After) == 0 && len(l.Lines) == 0
+}
+
+// skip moves lines up to given index to pending lines.
+func (e *exporter) skip(to int) {
+	if to > e.pos {
+		e.pending = append(e.pending, e.lines[e.pos:to]...)
+		e.pos = to
+	}
+}
+
+// assign adds pending lines after the last cell.
+func (e *exporter) assign() {
+	if len(e.pending) == 0 {
+		return
+	}
+	if len(e.nb.Cells) == 0 {
+		e.nb.Metadata.Literate.Before = e.pending
+	} else {
+		last := e.nb.Cells[len(e.nb.Cells)-1].Metadata.Literate
+		last.After = append(last.After, e.pending...)
+	}
+	e.pending = nil
+}
+
+// add appends new cell that ends before given line.
+func (e *exporter) add(cell Cell, end int) {
+	e.assign()
+	if cell.Metadata.Literate == nil {
+		cell.Metadata.Literate = &Layout{}
+	}
+	e.nb.Cells = append(e.nb.Cells, cell)
+	e.pos = end
+}
+
+// indentOf returns leading white space of line.
+func indentOf(line string) string {
+	return line[:len(line)-len(strings.TrimLeft(line, " \t"))]
+}
+
+// prose converts prose block into Markdown cells, one per paragraph.
+// Paragraphs are separated by empty lines.
+func (e *exporter) prose(b literate.Block) {
+	first := b.Start - 1
+	start := -1
+	for i := first; i < b.End; i++ {
+		if strings.TrimSpace(e.lines[i]) != "" {
+			if start < 0 {
+				e.skip(i)
+				start = i
+			}
+			continue
+		}
+		if start >= 0 {
+			e.paragraph(b.Lines[start-first:i-first], b.Lang, e.lines[start:i], i)
+			start = -1
+		}
+	}
+	if start >= 0 {
+		e.paragraph(b.Lines[start-first:b.End-first], b.Lang, e.lines[start:b.End], b.End)
+	}
+}
+
+// paragraph adds one Markdown cell. Original lines are stored in layout
+// only when they can not be generated from Markdown.
+func (e *exporter) paragraph(lines []string, lang string, original []string, end int) {
+	layout := &Layout{Indent: indentOf(original[0]), Lang: lang}
+	if !equalLines(proseLines(e.language, lines, lang, layout.Indent), original) {
+		layout.Lines = original
+	}
+	e.add(Cell{
+		Type:     Markdown,
+		Source:   TextLines(lines),
+		Metadata: CellMetadata{Literate: layout},
+	}, end)
+}
+
+// equalLines checks whether two lists of lines are the same.
+func equalLines(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// isWrapper checks whether line is part of program structure that is not
+// valid in notebook cell, i.e. build constraint, package clause or header
+// and end of main.
+func (e *exporter) isWrapper(line string) bool {
+	if e.language.Name != literate.Go.Name {
+		return false
+	}
+	switch {
+	case strings.HasPrefix(line, "//go:build "), strings.HasPrefix(line, "package "):
+		return true
+	case line == "func main() {":
+		e.inMain = true
+		return true
+	case line == "}" && e.inMain:
+		e.inMain = false
+		return true
+	}
+	return false
+}
+
+// code converts code block into code cells and raw cells containing
+// the program structure.
+func (e *exporter) code(b literate.Block) {
+	e.skip(b.Start - 1)
+	start := b.Start - 1
+	wrapper, seen := false, false
+	for i := b.Start - 1; i < b.End; i++ {
+		line := e.lines[i]
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		w := e.isWrapper(line)
+		if seen && w != wrapper {
+			e.codeCell(e.lines[start:i], wrapper, i)
+			start = i
+		}
+		wrapper, seen = w, true
+	}
+	e.codeCell(e.lines[start:b.End], wrapper, b.End)
+}
+
+// codeCell adds either raw cell with program structure or code cell with
+// code without common indentation.
+func (e *exporter) codeCell(lines []string, wrapper bool, end int) {
+	// empty lines between code and program structure are not part of
+	// any cell
+	n := len(lines)
+	for n > 0 && strings.TrimSpace(lines[n-1]) == "" {
+		n--
+	}
+	defer e.skip(end)
+	lines, end = lines[:n], end-(len(lines)-n)
+
+	if wrapper {
+		e.add(Cell{Type: Raw, Source: TextLines(lines)}, end)
+		return
+	}
+	indent, seen := "", false
+	for _, line := range lines {
+		switch {
+		case strings.TrimSpace(line) == "":
+			continue
+		case !seen:
+			indent, seen = indentOf(line), true
+		default:
+			indent = commonPrefix(indent, indentOf(line))
+		}
+	}
+	source := make([]string, len(lines))
+	for i, line := range lines {
+		source[i] = strings.TrimPrefix(line, indent)
+	}
+	e.add(Cell{
+		Type:     Code,
+		Source:   TextLines(source),
+		Metadata: CellMetadata{Literate: &Layout{Indent: indent}},
+	}, end)
+}
+
+// commonPrefix returns common prefix of two strings.
+func commonPrefix(a, b string) string {
+	n := 0
+	for n < len(a) && n < len(b) && a[n] == b[n] {
+		n++
+	}
+	return a[:n]
+}
+
+// output converts expected output either to output of the last code cell,
+// or to Markdown cell when prose is written between code and output.
+func (e *exporter) output(b literate.Block) error {
+	style, verbatim := CommentStyle, false
+	from, to := b.Start-1, b.End
+	lines := b.Lines
+	if !strings.HasPrefix(strings.TrimLeft(e.lines[from], " \t"), e.language.LineComment) {
+		// block comment, content is read directly from source as
+		// trailing empty lines are not part of the block
+		style = BlockStyle
+		from--
+		if from < 0 || strings.TrimSpace(e.lines[from]) != "/*" {
+			return fmt.Errorf("expected output must start with /* on separate line")
+		}
+		for to < len(e.lines) && !strings.HasPrefix(strings.TrimSpace(e.lines[to]), "*/") {
+			to++
+		}
+		if to == len(e.lines) || strings.TrimSpace(e.lines[to]) != "*/" {
+			return fmt.Errorf("expected output must end with */ on separate line")
+		}
+		indent := indentOf(e.lines[from])
+		lines = nil
+		for _, line := range e.lines[from+1 : to] {
+			line = strings.TrimPrefix(line, indent)
+			if line != "" && !strings.HasPrefix(line, "   ") {
+				verbatim = true
+			}
+			lines = append(lines, line)
+		}
+		if !verbatim {
+			for i, line := range lines {
+				lines[i] = strings.TrimPrefix(line, "   ")
+			}
+		}
+		to++
+	}
+	indent := indentOf(e.lines[from])
+	e.skip(from)
+
+	if n := len(e.nb.Cells); n > 0 && e.nb.Cells[n-1].Type == Code {
+		cell := &e.nb.Cells[n-1]
+		cell.Outputs = append(cell.Outputs, StreamOutput(lines))
+		layout := cell.Metadata.Literate
+		layout.Outputs = append(layout.Outputs, OutputLayout{Style: style, Indent: indent, Before: e.pending, Verbatim: verbatim})
+		e.pending = nil
+		e.pos = to
+		return nil
+	}
+
+	source := make([]string, len(lines))
+	for i, line := range lines {
+		if line != "" {
+			source[i] = "    " + line
+		}
+	}
+	e.add(Cell{
+		Type:     Markdown,
+		Source:   TextLines(source),
+		Metadata: CellMetadata{Literate: &Layout{Kind: OutputKind, Indent: indent, Style: style, Verbatim: verbatim}},
+	}, to)
+	return nil
+}
@@ -0,0 +1,180 @@
+/*
+Copyright © 2020 Pavel Tisnovsky
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notebook
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+)
+
+// commentSource contains expected output in line comments, as gonum.go.
+const commentSource = `package main
+
+import "fmt"
+
+func main() {
+	// Součet dvou čísel.
+	//[en] Sum of two numbers.
+	x := 1 + 2
+
+	fmt.Println(x)
+	//     3
+
+	// Výpis řetězce
+	// na dvou řádcích.
+	fmt.Println("a")
+	fmt.Println("b")
+	//     a
+	//     b
+}
+`
+
+// blockSource contains expected output in block comments, as
+// gonum_output_as_comments.go.
+const blockSource = `package main
+
+import "fmt"
+
+func main() {
+	// Součin dvou čísel.
+	fmt.Println(2 * 3)
+
+	/*
+	   6
+	*/
+}
+`
+
+// pythonSource is literate Python source.
+const pythonSource = `# Součet dvou čísel.
+x = 1 + 2
+
+print(x)
+#     3
+`
+
+// cellKind is type of cell and whether it has any output.
+type cellKind struct {
+	Type    string
+	Outputs int
+}
+
+func TestExport(t *testing.T) {
+	tests := []struct {
+		name     string
+		filename string
+		src      string
+		kernel   string
+		cells    []cellKind
+	}{
+		{"comment style", "comment.go", commentSource, "gophernotes", []cellKind{
+			{Raw, 0}, {Code, 0}, {Raw, 0},
+			{Markdown, 0}, {Markdown, 0}, {Code, 1},
+			{Markdown, 0}, {Code, 1},
+			{Raw, 0},
+		}},
+		{"block style", "block.go", blockSource, "gophernotes", []cellKind{
+			{Raw, 0}, {Code, 0}, {Raw, 0},
+			{Markdown, 0}, {Code, 1},
+			{Raw, 0},
+		}},
+		{"python", "sum.py", pythonSource, "python3", []cellKind{
+			{Markdown, 0}, {Code, 1},
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			nb, err := Export(tt.filename, []byte(tt.src))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if nb.Format != 4 || nb.Minor != 4 {
+				t.Errorf("nbformat = %d.%d, want 4.4", nb.Format, nb.Minor)
+			}
+			if nb.Metadata.Kernelspec == nil || nb.Metadata.Kernelspec.Name != tt.kernel {
+				t.Errorf("kernelspec = %+v, want %s", nb.Metadata.Kernelspec, tt.kernel)
+			}
+			if nb.Metadata.LanguageInfo == nil || nb.Metadata.LanguageInfo.Name != nb.Metadata.Kernelspec.Language {
+				t.Errorf("language_info = %+v", nb.Metadata.LanguageInfo)
+			}
+			if l := nb.Metadata.Literate; l == nil || l.Source != tt.filename {
+				t.Errorf("literate metadata = %+v, want source %s", l, tt.filename)
+			}
+			var cells []cellKind
+			for _, cell := range nb.Cells {
+				cells = append(cells, cellKind{cell.Type, len(cell.Outputs)})
+			}
+			if !reflect.DeepEqual(cells, tt.cells) {
+				t.Errorf("cells = %v, want %v", cells, tt.cells)
+			}
+		})
+	}
+}
+
+func TestExportOutputs(t *testing.T) {
+	nb, err := Export("comment.go", []byte(commentSource))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var outputs []string
+	for _, cell := range nb.Cells {
+		for _, output := range cell.Outputs {
+			outputs = append(outputs, output.PlainText())
+		}
+	}
+	want := []string{"3\n", "a\nb\n"}
+	if !reflect.DeepEqual(outputs, want) {
+		t.Errorf("outputs = %q, want %q", outputs, want)
+	}
+}
+
+func TestRoundTrip(t *testing.T) {
+	tests := []struct {
+		filename string
+		src      string
+	}{
+		{"comment.go", commentSource},
+		{"block.go", blockSource},
+		{"sum.py", pythonSource},
+	}
+	for _, tt := range tests {
+		t.Run(tt.filename, func(t *testing.T) {
+			nb, err := Export(tt.filename, []byte(tt.src))
+			if err != nil {
+				t.Fatal(err)
+			}
+			// the notebook is stored in JSON and read again, as by
+			// go2ipynb and ipynb2go
+			var buffer bytes.Buffer
+			if err := Write(&buffer, nb); err != nil {
+				t.Fatal(err)
+			}
+			nb, err = Read(&buffer)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, err := Import(nb, Options{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(got) != tt.src {
+				t.Errorf("Import(Export(src)) =\n%s\nwant\n%s", got, tt.src)
+			}
+		})
+	}
+}
@@ -0,0 +1,213 @@
+/*
+Copyright © 2020 Pavel Tisnovsky
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notebook
+
+import (
+	"strings"
+
+	"github.com/tisnik/literate-programming-examples/literate"
+)
+
+// languageOf returns language of code cells in notebook.
+func languageOf(nb *Notebook) literate.Language {
+	name := ""
+	switch {
+	case nb.Metadata.Literate != nil && nb.Metadata.Literate.Language != "":
+		name = nb.Metadata.Literate.Language
+	case nb.Metadata.LanguageInfo != nil:
+		name = nb.Metadata.LanguageInfo.Name
+	case nb.Metadata.Kernelspec != nil:
+		name = nb.Metadata.Kernelspec.Language
+	}
+	if strings.EqualFold(name, literate.Python.Name) {
+		return literate.Python
+	}
+	return literate.Go
+}
+
+// Options of import.
+type Options struct {
+	// Style of expected output that has no layout stored in metadata,
+	// CommentStyle or BlockStyle.
+	Style string
+}
+
+// exported checks whether notebook has been exported from literate source,
+// i.e. whether it contains layout of the source or program structure in
+// raw cells.
+func exported(nb *Notebook) bool {
+	if nb.Metadata.Literate != nil {
+		return true
+	}
+	for _, cell := range nb.Cells {
+		if cell.Type == Raw && strings.HasPrefix(cell.Source.String(), "package ") {
+			return true
+		}
+	}
+	return false
+}
+
+// Import converts notebook into literate source. Notebooks exported by
+// Export are converted using layout stored in metadata, so they are
+// converted back to the original source. Other Go notebooks are assembled
+// into one program, see Assemble.
+func Import(nb *Notebook, options Options) ([]byte, error) {
+	if options.Style == "" {
+		options.Style = CommentStyle
+	}
+	if !exported(nb) && languageOf(nb).Name == literate.Go.Name {
+		return Assemble(nb, options)
+	}
+	return restore(nb, options), nil
+}
+
+// restore converts cells one by one using layout stored in metadata. Cells
+// without layout (for example cells added in Jupyter) are indented in the
+// same way as the preceding cell.
+func restore(nb *Notebook, options Options) []byte {
+	language := languageOf(nb)
+	var lines []string
+	if nb.Metadata.Literate != nil {
+		lines = append(lines, nb.Metadata.Literate.Before...)
+	}
+
+	indent := ""
+	for _, cell := range nb.Cells {
+		layout := Layout{Indent: indent}
+		if cell.Metadata.Literate != nil {
+			layout = *cell.Metadata.Literate
+		} else if cell.Type == Code {
+			// prose is written right before code it describes
+			layout.After = []string{""}
+		}
+		source := cell.Source.Lines()
+
+		switch cell.Type {
+		case Raw:
+			lines = append(lines, source...)
+			// cells added after header of main belong to its body
+			switch strings.TrimSpace(cell.Source.String()) {
+			case "func main() {":
+				indent = "\t"
+			case "}":
+				indent = ""
+			}
+		case Markdown:
+			indent = layout.Indent
+			if layout.Kind == OutputKind {
+				for i, line := range source {
+					source[i] = strings.TrimPrefix(line, "    ")
+				}
+				o := OutputLayout{Style: layout.Style, Indent: layout.Indent, Verbatim: layout.Verbatim}
+				lines = append(lines, outputLines(language, source, o)...)
+				break
+			}
+			prose := proseLines(language, source, layout.Lang, layout.Indent)
+			lines = append(lines, originalLines(prose, layout.Lines)...)
+		case Code:
+			indent = layout.Indent
+			for _, line := range source {
+				if line != "" {
+					line = layout.Indent + line
+				}
+				lines = append(lines, line)
+			}
+			index := 0
+			for _, output := range cell.Outputs {
+				text := output.PlainText()
+				if text == "" {
+					continue
+				}
+				o := OutputLayout{Style: options.Style, Indent: layout.Indent}
+				if index < len(layout.Outputs) {
+					o = layout.Outputs[index]
+				}
+				index++
+				lines = append(lines, o.Before...)
+				lines = append(lines, outputLines(language, textLines(text), o)...)
+			}
+		}
+		lines = append(lines, layout.After...)
+	}
+	return []byte(strings.Join(lines, "\n") + "\n")
+}
+
+// textLines splits output into lines, the last new line is ignored.
+func textLines(text string) []string {
+	return strings.Split(strings.TrimSuffix(text, "\n"), "\n")
+}
+
+// proseLines converts Markdown into comments.
+func proseLines(language literate.Language, source []string, lang, indent string) []string {
+	marker := indent + language.LineComment
+	if lang != "" {
+		marker += "[" + lang + "]"
+	}
+	lines := make([]string, len(source))
+	for i, line := range source {
+		lines[i] = marker
+		if line != "" {
+			lines[i] += " " + line
+		}
+	}
+	return lines
+}
+
+// originalLines replaces generated lines of prose by the original ones
+// stored in layout. Lines edited in notebook contain different words than
+// the original ones, so they are kept as generated.
+func originalLines(generated, original []string) []string {
+	if len(generated) != len(original) {
+		return generated
+	}
+	lines := make([]string, len(generated))
+	for i, line := range generated {
+		lines[i] = line
+		if equalLines(strings.Fields(line), strings.Fields(original[i])) {
+			lines[i] = original[i]
+		}
+	}
+	return lines
+}
+
+// outputLines converts output into comments of given style.
+func outputLines(language literate.Language, output []string, layout OutputLayout) []string {
+	var lines []string
+	indent := layout.Indent
+	if layout.Style == BlockStyle && language.BlockComments {
+		margin := "   "
+		if layout.Verbatim {
+			margin = ""
+		}
+		lines = append(lines, indent+"/*")
+		for _, line := range output {
+			if line != "" {
+				line = indent + margin + line
+			}
+			lines = append(lines, line)
+		}
+		return append(lines, indent+"*/")
+	}
+	for _, line := range output {
+		comment := indent + language.LineComment
+		if line != "" {
+			comment += literate.OutputIndent + line
+		}
+		lines = append(lines, comment)
+	}
+	return lines
+}
@@ -0,0 +1,85 @@
+/*
+Copyright © 2020 Pavel Tisnovsky
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notebook
+
+import (
+	"go/ast"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// readNotebook reads notebook from testdata directory.
+func readNotebook(t *testing.T, name string) *Notebook {
+	t.Helper()
+	f, err := os.Open(filepath.Join("testdata", name))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	nb, err := Read(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return nb
+}
+
+// typeCheck checks that source is valid program, i.e. that it would be
+// compiled.
+func typeCheck(t *testing.T, src []byte) {
+	t.Helper()
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, "main.go", src, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	config := types.Config{Importer: importer.ForCompiler(fset, "source", nil)}
+	if _, err := config.Check("main", fset, []*ast.File{f}, nil); err != nil {
+		t.Errorf("assembled program can not be compiled: %v", err)
+	}
+}
+
+func TestImportForeign(t *testing.T) {
+	tests := []struct {
+		style  string
+		golden string
+	}{
+		{CommentStyle, "foreign_comment.go"},
+		{BlockStyle, "foreign_block.go"},
+	}
+	nb := readNotebook(t, "foreign.ipynb")
+	for _, tt := range tests {
+		t.Run(tt.style, func(t *testing.T) {
+			got, err := Import(nb, Options{Style: tt.style})
+			if err != nil {
+				t.Fatal(err)
+			}
+			want, err := os.ReadFile(filepath.Join("testdata", tt.golden))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(got) != string(want) {
+				t.Errorf("Import(%s) =\n%s\nwant\n%s", tt.style, got, want)
+			}
+			typeCheck(t, got)
+		})
+	}
+}
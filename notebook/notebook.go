@@ -0,0 +1,307 @@
+/*
+Copyright © 2020 Pavel Tisnovsky
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package notebook converts literate sources to Jupyter notebooks (nbformat
+// 4) and back. Prose becomes Markdown cells, code becomes code cells and
+// expected output is stored as output of the code cell that prints it.
+// Layout of the source that can not be expressed by cells (indentation,
+// empty lines, style of expected output) is stored in cell metadata under
+// the "literate" key, so exported notebook can be converted back to the
+// original source.
+package notebook
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"strings"
+)
+
+// Types of cells.
+const (
+	Markdown = "markdown"
+	Code     = "code"
+	Raw      = "raw"
+)
+
+// Styles of expected output in literate sources.
+const (
+	// CommentStyle is output written in line comments indented by four
+	// spaces, as in gonum.go.
+	CommentStyle = "comment"
+	// BlockStyle is output written in block comment, as in
+	// gonum_output_as_comments.go.
+	BlockStyle = "block"
+)
+
+// Text is multiline string stored as list of lines, each line except the
+// last one ends with new line. Plain string is accepted too.
+type Text []string
+
+// NewText splits text into lines.
+func NewText(s string) Text {
+	if s == "" {
+		return Text{}
+	}
+	lines := strings.SplitAfter(s, "\n")
+	if lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	return Text(lines)
+}
+
+// TextLines constructs text from lines without new lines.
+func TextLines(lines []string) Text {
+	return NewText(strings.Join(lines, "\n"))
+}
+
+// String returns the whole text.
+func (t Text) String() string {
+	return strings.Join(t, "")
+}
+
+// Lines returns text split into lines without new lines. Text ending with
+// new line ends with empty line.
+func (t Text) Lines() []string {
+	s := t.String()
+	if s == "" {
+		return nil
+	}
+	return strings.Split(s, "\n")
+}
+
+// UnmarshalJSON accepts both string and list of strings.
+func (t *Text) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err == nil {
+		*t = NewText(s)
+		return nil
+	}
+	var lines []string
+	if err := json.Unmarshal(data, &lines); err != nil {
+		return err
+	}
+	*t = lines
+	return nil
+}
+
+// Notebook is Jupyter notebook in nbformat 4.
+type Notebook struct {
+	Cells    []Cell   `json:"cells"`
+	Metadata Metadata `json:"metadata"`
+	Format   int      `json:"nbformat"`
+	Minor    int      `json:"nbformat_minor"`
+}
+
+// Metadata of notebook.
+type Metadata struct {
+	Kernelspec   *Kernelspec   `json:"kernelspec,omitempty"`
+	LanguageInfo *LanguageInfo `json:"language_info,omitempty"`
+	Literate     *Document     `json:"literate,omitempty"`
+}
+
+// Kernelspec selects kernel used to run the notebook.
+type Kernelspec struct {
+	DisplayName string `json:"display_name"`
+	Language    string `json:"language,omitempty"`
+	Name        string `json:"name"`
+}
+
+// LanguageInfo describes programming language of code cells.
+type LanguageInfo struct {
+	FileExtension string `json:"file_extension,omitempty"`
+	MimeType      string `json:"mimetype,omitempty"`
+	Name          string `json:"name"`
+}
+
+// Document contains information about literate source the notebook was
+// exported from.
+type Document struct {
+	Source   string `json:"source,omitempty"`
+	Language string `json:"language,omitempty"`
+	// Before contains lines preceding the first cell.
+	Before []string `json:"before,omitempty"`
+}
+
+// Cell is one cell of notebook.
+type Cell struct {
+	Type           string
+	Metadata       CellMetadata
+	Source         Text
+	Outputs        []Output
+	ExecutionCount *int
+}
+
+// CellMetadata contains metadata used by this package, other metadata are
+// ignored.
+type CellMetadata struct {
+	Literate *Layout `json:"literate,omitempty"`
+}
+
+// Layout describes how cell is written in literate source.
+type Layout struct {
+	// Kind is "output" for Markdown cell containing expected output that
+	// does not follow code.
+	Kind string `json:"kind,omitempty"`
+	// Indent is prepended to all lines of cell.
+	Indent string `json:"indent,omitempty"`
+	// Lang is language marker of translated prose.
+	Lang string `json:"lang,omitempty"`
+	// Style and Verbatim describe expected output for cells of kind
+	// "output", see OutputLayout.
+	Style    string `json:"style,omitempty"`
+	Verbatim bool   `json:"verbatim,omitempty"`
+	// Outputs describes layout of outputs of code cell.
+	Outputs []OutputLayout `json:"outputs,omitempty"`
+	// Lines contains original lines of prose when they can not be
+	// generated from Markdown, for example when they differ in white
+	// space after the comment marker.
+	Lines []string `json:"lines,omitempty"`
+	// After contains lines between this cell and the next one, usually
+	// empty lines.
+	After []string `json:"after,omitempty"`
+}
+
+// OutputLayout describes how output of code cell is written in literate
+// source.
+type OutputLayout struct {
+	Style  string   `json:"style"`
+	Indent string   `json:"indent"`
+	Before []string `json:"before,omitempty"`
+	// Verbatim is set for block comments with lines that are not
+	// indented by three spaces, such lines are stored as they are.
+	Verbatim bool `json:"verbatim,omitempty"`
+}
+
+// cellJSON is representation of all cell types, code cells require
+// outputs and execution count while other cells must not contain them.
+type cellJSON struct {
+	Type           string       `json:"cell_type"`
+	ExecutionCount *int         `json:"execution_count"`
+	Metadata       CellMetadata `json:"metadata"`
+	Outputs        []Output     `json:"outputs"`
+	Source         Text         `json:"source"`
+}
+
+// otherCellJSON is representation of Markdown and raw cells.
+type otherCellJSON struct {
+	Type     string       `json:"cell_type"`
+	Metadata CellMetadata `json:"metadata"`
+	Source   Text         `json:"source"`
+}
+
+// MarshalJSON writes fields required by cell type.
+func (c Cell) MarshalJSON() ([]byte, error) {
+	source := c.Source
+	if source == nil {
+		source = Text{}
+	}
+	if c.Type != Code {
+		return marshal(otherCellJSON{Type: c.Type, Metadata: c.Metadata, Source: source})
+	}
+	outputs := c.Outputs
+	if outputs == nil {
+		outputs = []Output{}
+	}
+	return marshal(cellJSON{
+		Type:           c.Type,
+		ExecutionCount: c.ExecutionCount,
+		Metadata:       c.Metadata,
+		Outputs:        outputs,
+		Source:         source,
+	})
+}
+
+// UnmarshalJSON reads cell of any type.
+func (c *Cell) UnmarshalJSON(data []byte) error {
+	var cell cellJSON
+	if err := json.Unmarshal(data, &cell); err != nil {
+		return err
+	}
+	*c = Cell{
+		Type:           cell.Type,
+		Metadata:       cell.Metadata,
+		Source:         cell.Source,
+		Outputs:        cell.Outputs,
+		ExecutionCount: cell.ExecutionCount,
+	}
+	return nil
+}
+
+// Output is output of code cell. Only text is read from outputs, other
+// data (images etc.) are kept as they are.
+type Output struct {
+	Data map[string]json.RawMessage `json:"data,omitempty"`
+	Name string                     `json:"name,omitempty"`
+	Type string                     `json:"output_type"`
+	Text Text                       `json:"text,omitempty"`
+}
+
+// StreamOutput constructs output printed to standard output.
+func StreamOutput(lines []string) Output {
+	return Output{Type: "stream", Name: "stdout", Text: NewText(strings.Join(lines, "\n") + "\n")}
+}
+
+// PlainText returns text of output, i.e. printed text or plain text
+// representation of result. Empty string is returned for outputs without
+// text (images etc.).
+func (o Output) PlainText() string {
+	switch o.Type {
+	case "stream":
+		// standard error output is not expected output
+		if o.Name != "stderr" {
+			return o.Text.String()
+		}
+	case "execute_result", "display_data":
+		var text Text
+		if raw, found := o.Data["text/plain"]; found && json.Unmarshal(raw, &text) == nil {
+			return text.String()
+		}
+	case "error":
+		// traceback is not part of program output
+	}
+	return ""
+}
+
+// Read reads notebook in JSON format.
+func Read(r io.Reader) (*Notebook, error) {
+	var nb Notebook
+	if err := json.NewDecoder(r).Decode(&nb); err != nil {
+		return nil, err
+	}
+	return &nb, nil
+}
+
+// marshal converts value to JSON, characters like < and > are not escaped
+// as Jupyter does not escape them either.
+func marshal(v interface{}) ([]byte, error) {
+	var buffer bytes.Buffer
+	encoder := json.NewEncoder(&buffer)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(v); err != nil {
+		return nil, err
+	}
+	return bytes.TrimSuffix(buffer.Bytes(), []byte("\n")), nil
+}
+
+// Write writes notebook in JSON format, indented in the same way as by
+// Jupyter.
+func Write(w io.Writer, nb *Notebook) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", " ")
+	return encoder.Encode(nb)
+}
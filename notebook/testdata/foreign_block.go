@@ -0,0 +1,31 @@
+// Výpočty s vektory
+
+package main
+
+import (
+	"fmt"
+	"math"
+	"strings"
+)
+
+// Délka vektoru.
+func length(v []float64) float64 {
+	sum := 0.0
+	for _, x := range v {
+		sum += x * x
+	}
+	return math.Sqrt(sum)
+}
+
+func main() {
+	v := []float64{3, 4}
+	fmt.Println(strings.ToUpper("vektor"))
+	/*
+	   VEKTOR
+	*/
+
+	fmt.Println(length(v))
+	/*
+	   5
+	*/
+}
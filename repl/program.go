@@ -0,0 +1,269 @@
+/*
+Copyright © 2020 Pavel Tisnovsky
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package repl
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/scanner"
+	"go/token"
+	"path"
+	"strconv"
+	"strings"
+)
+
+// marker separates outputs of sections, it is written to standard output
+// before code of each section placed directly into function main.
+const marker = "\x1e"
+
+// Extra is index of output of extra code added to program.
+const Extra = -1
+
+// markerPackage is name under which package os is imported to write
+// markers.
+const markerPackage = "literateOS"
+
+// tracker follows nesting of braces in code to find out whether code is
+// placed directly into function main.
+type tracker struct {
+	depth int
+	// mainDepth is depth of body of function main, zero outside main
+	mainDepth   int
+	expectMain  bool
+	previousTok token.Token
+}
+
+// feed processes next part of code.
+func (t *tracker) feed(code string) {
+	var s scanner.Scanner
+	fset := token.NewFileSet()
+	file := fset.AddFile("", fset.Base(), len(code))
+	s.Init(file, []byte(code), nil, 0)
+	for {
+		_, tok, lit := s.Scan()
+		if tok == token.EOF {
+			return
+		}
+		switch tok {
+		case token.IDENT:
+			if lit == "main" && t.previousTok == token.FUNC && t.depth == 0 {
+				t.expectMain = true
+			}
+		case token.LBRACE:
+			t.depth++
+			if t.expectMain {
+				t.mainDepth = t.depth
+				t.expectMain = false
+			}
+		case token.RBRACE:
+			if t.depth == t.mainDepth {
+				t.mainDepth = 0
+			}
+			t.depth--
+		}
+		t.previousTok = tok
+	}
+}
+
+// inMain checks whether the next code is placed directly into function
+// main.
+func (t *tracker) inMain() bool {
+	return t.mainDepth > 0 && t.depth == t.mainDepth
+}
+
+// Runnable checks whether code of section is placed in function main, code
+// written before (package clause, imports) can not be run on its own.
+func (s *Session) Runnable(index int) bool {
+	var t tracker
+	for i := 0; i < index; i++ {
+		t.feed(s.Code(i))
+	}
+	return t.inMain()
+}
+
+// Program assembles code of sections up to given one into program. Extra
+// code is added after code of the last section, it has to be placed
+// directly in function main. Unclosed blocks are closed, variables declared
+// in main are marked as used and unused imports are replaced by blank
+// imports, so the program can be compiled even when the rest of document
+// is missing.
+func (s *Session) Program(index int, extra string) ([]byte, error) {
+	var src strings.Builder
+	var t tracker
+	markers := false
+	for i := 0; i <= index; i++ {
+		if t.inMain() {
+			fmt.Fprintf(&src, "%s.Stdout.WriteString(%q)\n", markerPackage, marker+strconv.Itoa(i)+"\n")
+			markers = true
+		}
+		code := s.Code(i)
+		src.WriteString(code)
+		src.WriteString("\n")
+		t.feed(code)
+	}
+	if extra != "" {
+		if !t.inMain() {
+			return nil, fmt.Errorf("code can be added only to function main")
+		}
+		fmt.Fprintf(&src, "%s.Stdout.WriteString(%q)\n", markerPackage, marker+strconv.Itoa(Extra)+"\n")
+		markers = true
+		src.WriteString(extra)
+		src.WriteString("\n")
+	}
+	src.WriteString(strings.Repeat("}\n", t.depth))
+
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "", src.String(), 0)
+	if err != nil {
+		return nil, err
+	}
+	if markers {
+		addImport(file, markerPackage, "os")
+	}
+	for _, importPath := range extraImports {
+		if strings.Contains(extra, path.Base(importPath)+".") {
+			addImport(file, "", importPath)
+		}
+	}
+	markUsed(file)
+	blankImports(file)
+
+	var buffer bytes.Buffer
+	if err := format.Node(&buffer, fset, file); err != nil {
+		return nil, err
+	}
+	return buffer.Bytes(), nil
+}
+
+// extraImports are packages imported automatically when they are used by
+// extra code.
+var extraImports = []string{
+	"fmt",
+	"github.com/tisnik/literate-programming-examples/matexpr",
+}
+
+// addImport imports package unless it is already imported under the same
+// name.
+func addImport(file *ast.File, name, importPath string) {
+	want := name
+	if want == "" {
+		want = path.Base(importPath)
+	}
+	for _, spec := range file.Imports {
+		if p, _ := strconv.Unquote(spec.Path.Value); p == importPath && packageName(spec) == want {
+			return
+		}
+	}
+	spec := &ast.ImportSpec{Path: &ast.BasicLit{Kind: token.STRING, Value: strconv.Quote(importPath)}}
+	if name != "" {
+		spec.Name = ast.NewIdent(name)
+	}
+	decl := &ast.GenDecl{Tok: token.IMPORT, Specs: []ast.Spec{spec}}
+	file.Decls = append([]ast.Decl{decl}, file.Decls...)
+	file.Imports = append(file.Imports, spec)
+}
+
+// mainFunc returns declaration of function main.
+func mainFunc(file *ast.File) *ast.FuncDecl {
+	for _, decl := range file.Decls {
+		if f, ok := decl.(*ast.FuncDecl); ok && f.Recv == nil && f.Name.Name == "main" {
+			return f
+		}
+	}
+	return nil
+}
+
+// markUsed adds assignment to blank identifier for each variable declared
+// directly in function main, as later sections using it are not part of
+// the program.
+func markUsed(file *ast.File) {
+	main := mainFunc(file)
+	if main == nil {
+		return
+	}
+	var names []string
+	for _, stmt := range main.Body.List {
+		switch stmt := stmt.(type) {
+		case *ast.AssignStmt:
+			if stmt.Tok != token.DEFINE {
+				continue
+			}
+			for _, lhs := range stmt.Lhs {
+				if ident, ok := lhs.(*ast.Ident); ok {
+					names = append(names, ident.Name)
+				}
+			}
+		case *ast.DeclStmt:
+			if decl, ok := stmt.Decl.(*ast.GenDecl); ok && decl.Tok == token.VAR {
+				for _, spec := range decl.Specs {
+					for _, ident := range spec.(*ast.ValueSpec).Names {
+						names = append(names, ident.Name)
+					}
+				}
+			}
+		}
+	}
+
+	seen := map[string]bool{"_": true}
+	for _, name := range names {
+		if seen[name] {
+			continue
+		}
+		seen[name] = true
+		main.Body.List = append(main.Body.List, &ast.AssignStmt{
+			Lhs: []ast.Expr{ast.NewIdent("_")},
+			Tok: token.ASSIGN,
+			Rhs: []ast.Expr{ast.NewIdent(name)},
+		})
+	}
+}
+
+// blankImports replaces imports that are not used by blank imports.
+func blankImports(file *ast.File) {
+	used := map[string]bool{}
+	ast.Inspect(file, func(n ast.Node) bool {
+		if selector, ok := n.(*ast.SelectorExpr); ok {
+			if ident, ok := selector.X.(*ast.Ident); ok {
+				used[ident.Name] = true
+			}
+		}
+		return true
+	})
+	for _, spec := range file.Imports {
+		name := packageName(spec)
+		if name != "_" && name != "." && !used[name] {
+			spec.Name = ast.NewIdent("_")
+		}
+	}
+}
+
+// packageName returns name under which package is imported. Name of
+// package is supposed to be the last element of its path.
+func packageName(spec *ast.ImportSpec) string {
+	if spec.Name != nil {
+		return spec.Name.Name
+	}
+	importPath, _ := strconv.Unquote(spec.Path.Value)
+	name := path.Base(importPath)
+	if i := strings.IndexAny(name, ".-"); i > 0 {
+		name = name[:i]
+	}
+	return name
+}
@@ -0,0 +1,94 @@
+/*
+Copyright © 2020 Pavel Tisnovsky
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package repl walks literate Go sources section by section. Code of all
+// sections up to the current one is assembled into one program that is run
+// by go run, so variables declared in earlier sections remain available.
+// Code of any section can be edited and edits can be undone to get back to
+// the state described in the document.
+package repl
+
+import (
+	"strings"
+
+	"github.com/tisnik/literate-programming-examples/literate"
+)
+
+// Section is prose followed by code it describes and expected output of
+// that code. New section starts with each prose written after code.
+type Section struct {
+	Index int
+	// Heading is the last heading written in this or earlier sections.
+	Heading string
+	Prose   string
+	Code    string
+	// Expected contains expected outputs written in the document.
+	Expected []string
+	Start    int
+	End      int
+}
+
+// Sections splits document into sections. Prose written in other
+// languages than the default one is skipped.
+func Sections(doc *literate.Document) []Section {
+	var sections []Section
+	var current *Section
+	heading := ""
+	hasCode := false
+
+	for _, block := range doc.Blocks {
+		if block.Kind == literate.Prose && block.Lang != "" {
+			continue
+		}
+		if current == nil || (block.Kind == literate.Prose && hasCode) {
+			sections = append(sections, Section{Index: len(sections), Heading: heading, Start: block.Start})
+			current = &sections[len(sections)-1]
+			hasCode = false
+		}
+		current.End = block.End
+
+		switch block.Kind {
+		case literate.Prose:
+			for _, line := range block.Lines {
+				if strings.HasPrefix(line, "#") {
+					heading = strings.TrimSpace(strings.TrimLeft(line, "#"))
+					current.Heading = heading
+				}
+			}
+			current.Prose = join(current.Prose, block.Text(), "\n\n")
+		case literate.Code:
+			current.Code = join(current.Code, block.Text(), "\n")
+			hasCode = true
+		case literate.Output:
+			// output written after prose belongs to the code of
+			// previous section
+			target := current
+			if !hasCode && len(sections) > 1 {
+				target = &sections[len(sections)-2]
+			}
+			target.Expected = append(target.Expected, block.Text())
+		}
+	}
+	return sections
+}
+
+// join joins two parts of text written in one section.
+func join(text, part, separator string) string {
+	if text == "" {
+		return part
+	}
+	return text + separator + part
+}
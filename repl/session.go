@@ -0,0 +1,167 @@
+/*
+Copyright © 2020 Pavel Tisnovsky
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package repl
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/tisnik/literate-programming-examples/literate"
+)
+
+// Session contains sections of one document together with edits made by
+// user.
+type Session struct {
+	Filename string
+	Sections []Section
+	// BuildDir is directory for assembled programs, it has to be inside
+	// the module so packages used by the document can be imported.
+	BuildDir string
+	// edits contains all versions of code of each section made by user,
+	// the last one is the current version
+	edits map[int][]string
+}
+
+// NewSession parses literate Go source.
+func NewSession(filename, buildDir string) (*Session, error) {
+	doc, err := literate.ParseFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	if doc.Language.Name != literate.Go.Name {
+		return nil, fmt.Errorf("%s: only Go sources can be run", filename)
+	}
+	return &Session{
+		Filename: filename,
+		Sections: Sections(doc),
+		BuildDir: buildDir,
+		edits:    map[int][]string{},
+	}, nil
+}
+
+// Code returns the current version of code of given section.
+func (s *Session) Code(index int) string {
+	if versions := s.edits[index]; len(versions) > 0 {
+		return versions[len(versions)-1]
+	}
+	return s.Sections[index].Code
+}
+
+// Edited checks whether code of section differs from the document.
+func (s *Session) Edited(index int) bool {
+	return s.Code(index) != s.Sections[index].Code
+}
+
+// Edit replaces code of section.
+func (s *Session) Edit(index int, code string) {
+	code = strings.TrimRight(code, "\n")
+	if code != s.Code(index) {
+		s.edits[index] = append(s.edits[index], code)
+	}
+}
+
+// Replace replaces the first occurrence of text in code of section.
+func (s *Session) Replace(index int, old, new string) error {
+	code := s.Code(index)
+	if !strings.Contains(code, old) {
+		return fmt.Errorf("%q not found in code of section %d", old, index)
+	}
+	s.Edit(index, strings.Replace(code, old, new, 1))
+	return nil
+}
+
+// Undo reverts the last edit of section. It returns false when the code is
+// the same as in document.
+func (s *Session) Undo(index int) bool {
+	versions := s.edits[index]
+	if len(versions) == 0 {
+		return false
+	}
+	s.edits[index] = versions[:len(versions)-1]
+	return true
+}
+
+// Reset reverts all edits of section.
+func (s *Session) Reset(index int) {
+	delete(s.edits, index)
+}
+
+// Result is result of running program assembled up to some section.
+type Result struct {
+	// Outputs contains output of each section placed in function main,
+	// indexed by section.
+	Outputs map[int]string
+	// Stderr contains compiler errors or panic of the program.
+	Stderr string
+	Err    error
+}
+
+// Output returns output of given section.
+func (r *Result) Output(index int) string {
+	return r.Outputs[index]
+}
+
+// Run assembles program up to given section and runs it. Extra code is
+// added after code of the section, see Program.
+func (s *Session) Run(index int, extra string) (*Result, error) {
+	src, err := s.Program(index, extra)
+	if err != nil {
+		return nil, err
+	}
+	if err := os.MkdirAll(s.BuildDir, 0o755); err != nil {
+		return nil, err
+	}
+	base := strings.TrimSuffix(filepath.Base(s.Filename), filepath.Ext(s.Filename))
+	program := filepath.Join(s.BuildDir, base+"_repl.go")
+	if err := os.WriteFile(program, src, 0o644); err != nil {
+		return nil, err
+	}
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.Command("go", "run", program)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	err = cmd.Run()
+
+	return &Result{
+		Outputs: splitOutputs(stdout.String()),
+		Stderr:  stderr.String(),
+		Err:     err,
+	}, nil
+}
+
+// splitOutputs splits standard output into outputs of sections using
+// markers. Output written before the first marker belongs to section 0.
+func splitOutputs(stdout string) map[int]string {
+	outputs := map[int]string{}
+	parts := strings.Split(stdout, marker)
+	outputs[0] = parts[0]
+	for _, part := range parts[1:] {
+		line, text, _ := strings.Cut(part, "\n")
+		index, err := strconv.Atoi(line)
+		if err != nil {
+			continue
+		}
+		outputs[index] = text
+	}
+	return outputs
+}
@@ -0,0 +1,62 @@
+/*
+Copyright © 2020 Pavel Tisnovsky
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package speedup
+
+import (
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/plotter"
+	"gonum.org/v1/plot/plotutil"
+	"gonum.org/v1/plot/vg"
+)
+
+// Plot draws throughput predicted by both models together with linear
+// (ideal) speedup. Format of the image is derived from file extension, for
+// example .png or .svg.
+func Plot(predictions []Prediction, title string, filename string) error {
+	if len(predictions) == 0 {
+		return nil
+	}
+	base := predictions[0].AmdahlThroughput
+
+	amdahl := make(plotter.XYs, len(predictions))
+	gustafson := make(plotter.XYs, len(predictions))
+	linear := make(plotter.XYs, len(predictions))
+	for i, p := range predictions {
+		x := float64(p.Consumers)
+		amdahl[i] = plotter.XY{X: x, Y: p.AmdahlThroughput}
+		gustafson[i] = plotter.XY{X: x, Y: p.GustafsonThroughput}
+		linear[i] = plotter.XY{X: x, Y: base * x}
+	}
+
+	p := plot.New()
+	p.Title.Text = title
+	p.X.Label.Text = "Consumers"
+	p.Y.Label.Text = "Messages per second"
+	p.Legend.Top = true
+	p.Legend.Left = true
+	p.Add(plotter.NewGrid())
+
+	err := plotutil.AddLinePoints(p,
+		"Amdahl", amdahl,
+		"Gustafson", gustafson,
+		"Linear", linear)
+	if err != nil {
+		return err
+	}
+
+	return p.Save(16*vg.Centimeter, 10*vg.Centimeter, filename)
+}
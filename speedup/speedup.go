@@ -0,0 +1,191 @@
+/*
+Copyright © 2020 Pavel Tisnovsky
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package speedup predicts throughput achievable by several consumers from
+// durations of individual steps measured on one consumer. Each step is
+// classified either as parallel (it can be performed by all consumers at
+// the same time) or serial (for example writes into SQLite database that
+// are serialized by the database itself). The prediction is computed by
+// Amdahl's law (fixed amount of work) and Gustafson's law (amount of work
+// grows with number of consumers).
+package speedup
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/tisnik/literate-programming-examples/consumer"
+)
+
+// Step is one step of consume message operation.
+type Step struct {
+	Name     string
+	Duration float64
+	Serial   bool
+}
+
+// Backends contains serial steps for supported storage backends. SQLite
+// serializes all writes, while PostgreSQL is able to store data from
+// several consumers concurrently.
+var Backends = map[string][]string{
+	"sqlite": {"DB store"},
+	"psql":   {},
+}
+
+// Classify constructs steps from names and durations. Steps listed in
+// serial are marked as serial, all other steps as parallel.
+func Classify(names []string, durations []float64, serial []string) ([]Step, error) {
+	if len(names) != len(durations) {
+		return nil, fmt.Errorf("%d names, but %d durations", len(names), len(durations))
+	}
+	steps := make([]Step, len(names))
+	for i, name := range names {
+		steps[i] = Step{Name: name, Duration: durations[i]}
+	}
+	for _, name := range serial {
+		found := false
+		for i := range steps {
+			if steps[i].Name == name {
+				steps[i].Serial = true
+				found = true
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("unknown step %q, available steps: %s",
+				name, strings.Join(names, ", "))
+		}
+	}
+	return steps, nil
+}
+
+// Statistic returns function that selects one value from statistic
+// computed for each step. Supported names are mean, median, min, max and
+// percentiles listed in consumer.Percentiles (e.g. p95).
+func Statistic(name string) (func(consumer.Stats) float64, error) {
+	switch name {
+	case "mean":
+		return func(s consumer.Stats) float64 { return s.Mean }, nil
+	case "median":
+		return func(s consumer.Stats) float64 { return s.Median }, nil
+	case "min":
+		return func(s consumer.Stats) float64 { return s.Min }, nil
+	case "max":
+		return func(s consumer.Stats) float64 { return s.Max }, nil
+	}
+	for i, p := range consumer.Percentiles {
+		if name == fmt.Sprintf("p%g", p) {
+			return func(s consumer.Stats) float64 {
+				if i < len(s.Percentiles) {
+					return s.Percentiles[i]
+				}
+				return math.NaN()
+			}, nil
+		}
+	}
+	return nil, fmt.Errorf("unknown statistic %q", name)
+}
+
+// FromStats constructs steps from statistic of step durations, using the
+// selected value (see Statistic) as duration of each step.
+func FromStats(names []string, stats []consumer.Stats, statistic string, serial []string) ([]Step, error) {
+	value, err := Statistic(statistic)
+	if err != nil {
+		return nil, err
+	}
+	durations := make([]float64, len(stats))
+	for i, s := range stats {
+		durations[i] = value(s)
+	}
+	return Classify(names, durations, serial)
+}
+
+// Total returns duration of all steps, i.e. duration of one consume
+// message operation.
+func Total(steps []Step) float64 {
+	total := 0.0
+	for _, step := range steps {
+		total += step.Duration
+	}
+	return total
+}
+
+// ParallelFraction computes fraction (0..1) of time spent in parallel
+// steps.
+func ParallelFraction(steps []Step) float64 {
+	total := Total(steps)
+	if total == 0 {
+		return 0
+	}
+	parallel := 0.0
+	for _, step := range steps {
+		if !step.Serial {
+			parallel += step.Duration
+		}
+	}
+	return parallel / total
+}
+
+// Amdahl returns speedup achievable by n consumers for fixed amount of
+// work when given fraction of the work can be performed in parallel.
+func Amdahl(parallelFraction float64, n int) float64 {
+	return 1 / ((1 - parallelFraction) + parallelFraction/float64(n))
+}
+
+// Gustafson returns scaled speedup achievable by n consumers when the
+// amount of parallel work grows with number of consumers.
+func Gustafson(parallelFraction float64, n int) float64 {
+	return (1 - parallelFraction) + parallelFraction*float64(n)
+}
+
+// Prediction contains speedup and throughput predicted for given number
+// of consumers.
+type Prediction struct {
+	Consumers           int
+	Amdahl              float64
+	Gustafson           float64
+	AmdahlThroughput    float64
+	GustafsonThroughput float64
+}
+
+// Predict computes predictions for 1..maxConsumers consumers. Unit is the
+// number of duration units in one second, for example 1e9 for durations
+// in nanoseconds.
+func Predict(steps []Step, maxConsumers int, unit float64) ([]Prediction, error) {
+	if maxConsumers < 1 {
+		return nil, fmt.Errorf("invalid number of consumers %d, at least one is needed", maxConsumers)
+	}
+	fraction := ParallelFraction(steps)
+	throughput := math.Inf(1)
+	if total := Total(steps); total != 0 {
+		throughput = unit / total
+	}
+
+	predictions := make([]Prediction, maxConsumers)
+	for i := range predictions {
+		n := i + 1
+		a := Amdahl(fraction, n)
+		g := Gustafson(fraction, n)
+		predictions[i] = Prediction{
+			Consumers:           n,
+			Amdahl:              a,
+			Gustafson:           g,
+			AmdahlThroughput:    throughput * a,
+			GustafsonThroughput: throughput * g,
+		}
+	}
+	return predictions, nil
+}
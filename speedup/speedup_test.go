@@ -0,0 +1,104 @@
+/*
+Copyright © 2020 Pavel Tisnovsky
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package speedup
+
+import (
+	"bytes"
+	"math"
+	"reflect"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+func TestLaws(t *testing.T) {
+	tests := []struct {
+		name      string
+		fraction  float64
+		n         int
+		amdahl    float64
+		gustafson float64
+	}{
+		{"one consumer", 0.8, 1, 1, 1},
+		{"serial work", 0, 8, 1, 1},
+		{"parallel work", 1, 8, 8, 8},
+		{"mixed work", 0.5, 4, 1.6, 2.5},
+		{"mostly parallel", 0.9, 10, 1 / 0.19, 9.1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Amdahl(tt.fraction, tt.n); math.Abs(got-tt.amdahl) > 1e-12 {
+				t.Errorf("Amdahl(%v, %d) = %v, want %v", tt.fraction, tt.n, got, tt.amdahl)
+			}
+			if got := Gustafson(tt.fraction, tt.n); math.Abs(got-tt.gustafson) > 1e-12 {
+				t.Errorf("Gustafson(%v, %d) = %v, want %v", tt.fraction, tt.n, got, tt.gustafson)
+			}
+		})
+	}
+}
+
+// testSteps are steps with durations in milliseconds, one quarter of time
+// is spent in serial step.
+func testSteps() []Step {
+	return []Step{
+		{Name: "Read", Duration: 1},
+		{Name: "Marshalling", Duration: 2},
+		{Name: "DB store", Duration: 1, Serial: true},
+	}
+}
+
+func TestPredict(t *testing.T) {
+	predictions, err := Predict(testSteps(), 3, 1e3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(predictions) != 3 {
+		t.Fatalf("%d predictions, want 3", len(predictions))
+	}
+	first := predictions[0]
+	if first.Consumers != 1 || first.Amdahl != 1 || first.Gustafson != 1 || first.AmdahlThroughput != 250 {
+		t.Errorf("prediction for one consumer = %+v", first)
+	}
+	last := predictions[2]
+	if last.Consumers != 3 || math.Abs(last.Amdahl-2) > 1e-12 || math.Abs(last.Gustafson-2.5) > 1e-12 {
+		t.Errorf("prediction for three consumers = %+v", last)
+	}
+	if math.Abs(last.GustafsonThroughput-625) > 1e-9 {
+		t.Errorf("Gustafson throughput = %v, want 625", last.GustafsonThroughput)
+	}
+}
+
+func TestPredictInvalidConsumers(t *testing.T) {
+	for _, n := range []int{0, -1} {
+		if _, err := Predict(testSteps(), n, 1e3); err == nil {
+			t.Errorf("Predict(%d consumers) returned no error", n)
+		}
+	}
+}
+
+func TestWriteTableHeader(t *testing.T) {
+	var buffer bytes.Buffer
+	if err := WriteTable(&buffer, nil); err != nil {
+		t.Fatal(err)
+	}
+	// columns are separated by at least two spaces
+	header := regexp.MustCompile(`\s{2,}`).Split(strings.TrimSpace(buffer.String()), -1)
+	want := []string{"Consumers", "Amdahl", "Amdahl/s", "Per minute", "Per hour", "Per day", "Gustafson", "Gustafson/s"}
+	if !reflect.DeepEqual(header, want) {
+		t.Errorf("header = %q, want %q", header, want)
+	}
+}
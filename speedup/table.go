@@ -0,0 +1,55 @@
+/*
+Copyright © 2020 Pavel Tisnovsky
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package speedup
+
+import (
+	"fmt"
+	"io"
+	"text/tabwriter"
+)
+
+// WriteSteps writes table with all steps, their durations, share of total
+// time and classification.
+func WriteSteps(w io.Writer, steps []Step) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', tabwriter.AlignRight)
+	total := Total(steps)
+	fmt.Fprintln(tw, "Step\tDuration\tShare\tKind\t")
+	for _, step := range steps {
+		kind := "parallel"
+		if step.Serial {
+			kind = "serial"
+		}
+		fmt.Fprintf(tw, "%s\t%.1f\t%.1f %%\t%s\t\n",
+			step.Name, step.Duration, 100*step.Duration/total, kind)
+	}
+	return tw.Flush()
+}
+
+// WriteTable writes speedup and throughput (messages per second, minute,
+// hour and day) predicted by both models.
+func WriteTable(w io.Writer, predictions []Prediction) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', tabwriter.AlignRight)
+	fmt.Fprintln(tw, "Consumers\tAmdahl\tAmdahl/s\tPer minute\tPer hour\tPer day\t"+
+		"Gustafson\tGustafson/s\t")
+	for _, p := range predictions {
+		fmt.Fprintf(tw, "%d\t%.3f\t%.1f\t%.0f\t%.0f\t%.0f\t%.3f\t%.1f\t\n",
+			p.Consumers, p.Amdahl, p.AmdahlThroughput,
+			p.AmdahlThroughput*60, p.AmdahlThroughput*3600, p.AmdahlThroughput*86400,
+			p.Gustafson, p.GustafsonThroughput)
+	}
+	return tw.Flush()
+}
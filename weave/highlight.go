@@ -0,0 +1,103 @@
+/*
+Copyright © 2020 Pavel Tisnovsky
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package weave
+
+import (
+	"html"
+	"regexp"
+	"strings"
+)
+
+// token patterns shared by all supported languages, the first matching
+// alternative wins
+var (
+	goToken = regexp.MustCompile(`(?s)(//[^\n]*|/\*.*?\*/)` +
+		"|(`[^`]*`" + `|"(?:[^"\\\n]|\\.)*"|'(?:[^'\\\n]|\\.)*')` +
+		`|(\b[0-9](?:[0-9a-fA-FxX_.]|[eE][+-])*i?)` +
+		`|([\pL_][\pL\pN_]*)`)
+	pythonToken = regexp.MustCompile(`(?s)(#[^\n]*)` +
+		`|((?:\b[rRbBfFuU]{1,2})?(?:"""(?:[^\\]|\\.)*?"""|'''(?:[^\\]|\\.)*?'''|"(?:[^"\\\n]|\\.)*"|'(?:[^'\\\n]|\\.)*'))` +
+		`|(\b[0-9](?:[0-9a-fA-FxX_.]|[eE][+-])*j?)` +
+		`|([\pL_][\pL\pN_]*)`)
+)
+
+// keywords of supported languages
+var (
+	goKeywords = words(`break case chan const continue default defer else
+		fallthrough for func go goto if import interface map package range
+		return select struct switch type var`)
+	goBuiltins = words(`append bool byte cap close complex complex64
+		complex128 copy delete error false float32 float64 imag int int8 int16
+		int32 int64 iota len make new nil panic print println real recover rune
+		string true uint uint8 uint16 uint32 uint64 uintptr any`)
+	pythonKeywords = words(`and as assert async await break class continue
+		def del elif else except finally for from global if import in is
+		lambda nonlocal not or pass raise return try while with yield`)
+	pythonBuiltins = words(`False None True abs dict enumerate float int len
+		list max min print range round set sorted str sum tuple type zip`)
+)
+
+// words converts whitespace separated list of words into set.
+func words(list string) map[string]bool {
+	set := map[string]bool{}
+	for _, word := range strings.Fields(list) {
+		set[word] = true
+	}
+	return set
+}
+
+// Highlight converts source code into HTML with tokens wrapped into span
+// elements. Classes used are the same for all languages: comment, string,
+// number, keyword and builtin.
+func Highlight(language string, code string) string {
+	pattern, keywords, builtins := goToken, goKeywords, goBuiltins
+	if language == "python" {
+		pattern, keywords, builtins = pythonToken, pythonKeywords, pythonBuiltins
+	}
+
+	var out strings.Builder
+	last := 0
+	for _, match := range pattern.FindAllStringSubmatchIndex(code, -1) {
+		start, end := match[0], match[1]
+		out.WriteString(html.EscapeString(code[last:start]))
+		last = end
+
+		text := code[start:end]
+		class := ""
+		switch {
+		case match[2] >= 0:
+			class = "comment"
+		case match[4] >= 0:
+			class = "string"
+		case match[6] >= 0:
+			class = "number"
+		case keywords[text]:
+			class = "keyword"
+		case builtins[text]:
+			class = "builtin"
+		}
+		if class == "" {
+			out.WriteString(html.EscapeString(text))
+			continue
+		}
+		out.WriteString(`<span class="` + class + `">`)
+		out.WriteString(html.EscapeString(text))
+		out.WriteString(`</span>`)
+	}
+	out.WriteString(html.EscapeString(code[last:]))
+	return out.String()
+}
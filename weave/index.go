@@ -0,0 +1,111 @@
+/*
+Copyright © 2020 Pavel Tisnovsky
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package weave
+
+import (
+	"html/template"
+	"io"
+	"time"
+)
+
+// Entry is one page listed in index.
+type Entry struct {
+	Title  string
+	Page   string
+	Source string
+	// Modified is date of the last change of source file.
+	Modified string
+	Headings []Heading
+}
+
+// Index is list of all pages generated from literate sources, together
+// with other pages found in the same directory.
+type Index struct {
+	Title      string
+	StyleSheet string
+	Generated  time.Time
+	Entries    []Entry
+	Others     []Entry
+}
+
+// NewEntry creates index entry for woven page stored in given file. The
+// heading used as title of page is not repeated in table of contents.
+func NewEntry(page *Page, filename string, modified string) Entry {
+	headings := page.Headings()
+	if len(headings) > 0 && headings[0].Level == 1 && headings[0].Text == page.Title {
+		headings = headings[1:]
+	}
+	return Entry{
+		Title:    page.Title,
+		Page:     filename,
+		Source:   page.Source,
+		Modified: modified,
+		Headings: headings,
+	}
+}
+
+// RenderIndex writes index as HTML document.
+func RenderIndex(w io.Writer, index *Index) error {
+	return indexTemplate.Execute(w, index)
+}
+
+// indexTemplate is template of index page. Only headings of the first
+// three levels are listed in the table of contents.
+var indexTemplate = template.Must(template.New("index").Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<meta name="viewport" content="width=device-width, initial-scale=1">
+<meta name="generator" content="literate-programming-examples weave">
+<title>{{.Title}}</title>
+<link rel="stylesheet" href="{{.StyleSheet}}">
+<script src="search-index.js"></script>
+<script src="search.js"></script>
+</head>
+<body class="index">
+<main class="index">
+<h1>{{.Title}}</h1>
+<form class="search" onsubmit="return false">
+<input type="search" id="search" placeholder="Hledat / Search" autocomplete="off">
+<ol id="search-results"></ol>
+</form>
+{{- range $entry := .Entries}}
+<article class="entry">
+<h2><a href="{{.Page}}">{{.Title}}</a></h2>
+<p class="meta">Source <code>{{.Source}}</code>{{if .Modified}}, last changed {{.Modified}}{{end}}</p>
+<ul class="toc">
+{{- range .Headings}}
+{{- if le .Level 3}}
+<li class="level-{{.Level}}"><a href="{{$entry.Page}}#{{.Anchor}}">{{.Text}}</a></li>
+{{- end}}
+{{- end}}
+</ul>
+</article>
+{{- end}}
+{{- if .Others}}
+<h2>Other pages</h2>
+<ul class="others">
+{{- range .Others}}
+<li><a href="{{.Page}}">{{.Title}}</a> <code>{{.Page}}</code></li>
+{{- end}}
+</ul>
+{{- end}}
+<footer class="meta">Regenerated {{.Generated.Format "2006-01-02 15:04 MST"}}</footer>
+</main>
+</body>
+</html>
+`))
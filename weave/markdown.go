@@ -0,0 +1,119 @@
+/*
+Copyright © 2020 Pavel Tisnovsky
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package weave
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/extension"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/text"
+)
+
+// markdown is converter used for all prose blocks. GitHub flavoured
+// Markdown is used, because the same sources are rendered on GitHub too.
+var markdown = goldmark.New(
+	goldmark.WithExtensions(extension.GFM),
+	goldmark.WithParserOptions(parser.WithAutoHeadingID()),
+)
+
+// anchors generates identifiers of headings, each identifier is unique
+// within one page.
+type anchors struct {
+	used map[string]bool
+}
+
+// newAnchors constructs generator of identifiers for a new page.
+func newAnchors() *anchors {
+	return &anchors{used: map[string]bool{}}
+}
+
+// Generate returns unique identifier derived from heading text.
+func (a *anchors) Generate(value []byte, kind ast.NodeKind) []byte {
+	slug := Slug(string(value))
+	if slug == "" {
+		slug = "heading"
+	}
+	id := slug
+	for i := 2; a.used[id]; i++ {
+		id = fmt.Sprintf("%s-%d", slug, i)
+	}
+	a.used[id] = true
+	return []byte(id)
+}
+
+// Put marks identifier as used.
+func (a *anchors) Put(value []byte) {
+	a.used[string(value)] = true
+}
+
+// Markdown converts prose written in Markdown into HTML. Identifiers of
+// headings are generated by given anchors, the headings are returned
+// together with HTML.
+func Markdown(prose string, ids *anchors) (string, []Heading, error) {
+	source := []byte(prose)
+	context := parser.NewContext(parser.WithIDs(ids))
+	document := markdown.Parser().Parse(text.NewReader(source), parser.WithContext(context))
+
+	var headings []Heading
+	err := ast.Walk(document, func(node ast.Node, entering bool) (ast.WalkStatus, error) {
+		heading, ok := node.(*ast.Heading)
+		if !ok || !entering {
+			return ast.WalkContinue, nil
+		}
+		id, _ := heading.AttributeString("id")
+		anchor, _ := id.([]byte)
+		headings = append(headings, Heading{
+			Level:  heading.Level,
+			Text:   strings.Join(strings.Fields(plainText(heading, source)), " "),
+			Anchor: string(anchor),
+		})
+		return ast.WalkSkipChildren, nil
+	})
+	if err != nil {
+		return "", nil, err
+	}
+
+	var buf bytes.Buffer
+	if err := markdown.Renderer().Render(&buf, source, document); err != nil {
+		return "", nil, err
+	}
+	return buf.String(), headings, nil
+}
+
+// plainText returns text of inline node without any markup.
+func plainText(node ast.Node, source []byte) string {
+	var buf bytes.Buffer
+	for child := node.FirstChild(); child != nil; child = child.NextSibling() {
+		switch n := child.(type) {
+		case *ast.Text:
+			buf.Write(n.Segment.Value(source))
+			if n.SoftLineBreak() {
+				buf.WriteByte(' ')
+			}
+		case *ast.String:
+			buf.Write(n.Value)
+		default:
+			buf.WriteString(plainText(child, source))
+		}
+	}
+	return buf.String()
+}
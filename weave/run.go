@@ -0,0 +1,188 @@
+/*
+Copyright © 2020 Pavel Tisnovsky
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package weave
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"html"
+	"html/template"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/tisnik/literate-programming-examples/dump"
+	"github.com/tisnik/literate-programming-examples/instrument"
+	"github.com/tisnik/literate-programming-examples/literate"
+)
+
+// Run contains output of program built from literate source.
+type Run struct {
+	// Stdout contains all lines written to standard output.
+	Stdout []string
+	// Records contain text printed by each print statement, in the
+	// order of execution.
+	Records []dump.Record
+}
+
+// OutputEnvVariable contains the name of environment variable with the
+// output directory of woven pages. Programs store generated images there,
+// so pages can refer to them by relative path.
+const OutputEnvVariable = "LITERATE_OUTPUT"
+
+// Execute instruments literate Go source, runs it by go run and collects
+// its output. Instrumented source is stored in buildDir that has to be
+// inside the module, so the dump package can be imported. The outputDir
+// is passed to the program in OutputEnvVariable.
+func Execute(filename string, buildDir string, outputDir string) (*Run, error) {
+	src, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	rewritten, _, err := instrument.Rewrite(filename, src)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := os.MkdirAll(buildDir, 0o755); err != nil {
+		return nil, err
+	}
+	base := strings.TrimSuffix(filepath.Base(filename), filepath.Ext(filename))
+	program := filepath.Join(buildDir, base+"_weave.go")
+	records := filepath.Join(buildDir, base+"_weave.jsonl")
+	if err := os.WriteFile(program, rewritten, 0o644); err != nil {
+		return nil, err
+	}
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.Command("go", "run", program)
+	cmd.Env = append(os.Environ(),
+		dump.EnvVariable+"="+records,
+		OutputEnvVariable+"="+outputDir)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf("%s: %w\n%s", filename, err, stderr.String())
+	}
+
+	run := &Run{Stdout: strings.Split(strings.TrimRight(stdout.String(), "\n"), "\n")}
+	run.Records, err = readRecords(records)
+	return run, err
+}
+
+// readRecords reads records stored by dump package. Only the first record
+// of each print statement is kept, because all records of one statement
+// contain the same text.
+func readRecords(filename string) ([]dump.Record, error) {
+	f, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var records []dump.Record
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 0, 64*1024), 64*1024*1024)
+	for scanner.Scan() {
+		var r dump.Record
+		if err := json.Unmarshal(scanner.Bytes(), &r); err != nil {
+			return nil, fmt.Errorf("%s: %w", filename, err)
+		}
+		if r.Index == 0 {
+			records = append(records, r)
+		}
+	}
+	return records, scanner.Err()
+}
+
+// Annotate adds real output of the program next to the code that printed
+// it and compares expected outputs written in the literate source with the
+// real output. Result of comparison is stored in each expected output.
+func (p *Page) Annotate(run *Run, tolerance literate.Tolerance) {
+	// text printed by statements in each code item
+	printed := map[position]*strings.Builder{}
+	for _, r := range run.Records {
+		k, found := p.codeAt(r.Line)
+		if !found {
+			continue
+		}
+		if printed[k] == nil {
+			printed[k] = &strings.Builder{}
+		}
+		printed[k].WriteString(r.Text)
+	}
+
+	from := 0
+	for s := range p.Sections {
+		section := &p.Sections[s]
+		var items []Item
+		for i, item := range section.Items {
+			if item.Kind == literate.Output {
+				m := literate.FindBlock(strings.Split(item.Text, "\n"), run.Stdout, from, tolerance)
+				item.Checked = true
+				item.Result = m.Result
+				item.Note = m.Describe(literate.Block{
+					Kind:  literate.Output,
+					Lines: strings.Split(item.Text, "\n"),
+					Start: item.Start,
+					End:   item.End,
+				})
+				if m.First >= 0 {
+					from = m.Last + 1
+				}
+			}
+			items = append(items, item)
+
+			if text := printed[position{s, i}]; text != nil && strings.TrimSpace(text.String()) != "" {
+				actual := strings.TrimRight(text.String(), "\n")
+				items = append(items, Item{
+					Kind:   literate.Output,
+					Actual: true,
+					Text:   actual,
+					HTML:   template.HTML(html.EscapeString(actual)),
+					Start:  item.Start,
+					End:    item.End,
+				})
+			}
+		}
+		section.Items = items
+	}
+}
+
+// position identifies one item of page.
+type position struct {
+	section int
+	item    int
+}
+
+// codeAt finds code item containing given line of source.
+func (p *Page) codeAt(line int) (position, bool) {
+	for s, section := range p.Sections {
+		if line < section.Start || line > section.End {
+			continue
+		}
+		for i, item := range section.Items {
+			if item.Kind == literate.Code && line >= item.Start && line <= item.End {
+				return position{s, i}, true
+			}
+		}
+	}
+	return position{}, false
+}
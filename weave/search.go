@@ -0,0 +1,206 @@
+/*
+Copyright © 2020 Pavel Tisnovsky
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package weave
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/tisnik/literate-programming-examples/literate"
+)
+
+// SearchIndexFile is name of generated search index. The index is stored
+// as JavaScript and not as JSON, because JSON files can not be loaded by
+// pages opened from file:// URL.
+const SearchIndexFile = "search-index.js"
+
+// SearchScriptFile is name of script with search box implementation.
+const SearchScriptFile = "search.js"
+
+// suffixes removed by Stem, longer suffixes have to be listed first. The
+// same list is used in search.js.
+var suffixes = []string{
+	"ovych", "ovymi", "eho", "emu", "ymi", "ach", "ich", "ami", "emi",
+	"ove", "ovi", "ych", "ym", "im", "em", "ou", "am", "ho", "mu",
+	"a", "e", "i", "o", "u", "y",
+}
+
+// Stem converts folded word into crude stem by removing common Czech
+// suffixes, so for example "matice", "matic" and "maticemi" have the same
+// stem. English words are affected too, but as the same function is used
+// for queries, it does not matter.
+func Stem(word string) string {
+	for _, suffix := range suffixes {
+		if strings.HasSuffix(word, suffix) && len(word)-len(suffix) >= 3 {
+			return word[:len(word)-len(suffix)]
+		}
+	}
+	return word
+}
+
+// regular expressions used to split text into words and identifiers, the
+// same word pattern is used for queries in search.js
+var (
+	wordRegexp       = regexp.MustCompile(`[\p{L}\p{N}_]+`)
+	identifierRegexp = regexp.MustCompile(`[\pL_][\pL\pN_]*`)
+)
+
+// ProseTerms returns search terms for words found in prose.
+func ProseTerms(text string) []string {
+	var terms []string
+	for _, word := range wordRegexp.FindAllString(Fold(text), -1) {
+		if len(word) >= 2 {
+			terms = append(terms, Stem(word))
+		}
+	}
+	return terms
+}
+
+// CodeTerms returns search terms for identifiers found in code. Identifiers
+// are not stemmed, they are just folded.
+func CodeTerms(code string) []string {
+	var terms []string
+	for _, identifier := range identifierRegexp.FindAllString(code, -1) {
+		if len(identifier) >= 2 {
+			terms = append(terms, Fold(identifier))
+		}
+	}
+	return terms
+}
+
+// SearchEntry is one section that can be found.
+type SearchEntry struct {
+	Page    string `json:"p"`
+	Anchor  string `json:"a"`
+	Title   string `json:"t"`
+	Snippet string `json:"s"`
+}
+
+// SearchIndex maps search terms to sections of all pages.
+type SearchIndex struct {
+	Entries []SearchEntry    `json:"entries"`
+	Terms   map[string][]int `json:"terms"`
+}
+
+// NewSearchIndex constructs empty index.
+func NewSearchIndex() *SearchIndex {
+	return &SearchIndex{Terms: map[string][]int{}}
+}
+
+// Add adds all sections of page stored in given file to index.
+func (s *SearchIndex) Add(page *Page, filename string) {
+	title := page.Title
+	for _, section := range page.Sections {
+		if len(section.Headings) > 0 {
+			title = section.Headings[len(section.Headings)-1].Text
+		}
+		terms := ProseTerms(section.Markdown)
+		for _, t := range section.Translations {
+			terms = append(terms, ProseTerms(t.Markdown)...)
+		}
+		for _, item := range section.Items {
+			if item.Kind == literate.Code {
+				terms = append(terms, CodeTerms(item.Text)...)
+			}
+		}
+		if len(terms) == 0 {
+			continue
+		}
+
+		id := len(s.Entries)
+		s.Entries = append(s.Entries, SearchEntry{
+			Page:    filename,
+			Anchor:  fmt.Sprintf("section-%d", section.Index),
+			Title:   page.Title + " › " + title,
+			Snippet: snippet(section),
+		})
+		seen := map[string]bool{}
+		for _, term := range terms {
+			if !seen[term] {
+				seen[term] = true
+				s.Terms[term] = append(s.Terms[term], id)
+			}
+		}
+	}
+}
+
+// markupRegexp matches Markdown markup removed from snippets.
+var markupRegexp = regexp.MustCompile("[#*_`>\\[\\]]+|\\]\\([^)]*\\)")
+
+// snippet returns beginning of prose (or code when there is no prose)
+// displayed in search results.
+func snippet(section Section) string {
+	text := section.Markdown
+	if strings.TrimSpace(text) == "" && len(section.Items) > 0 {
+		text = section.Items[0].Text
+	}
+	text = strings.Join(strings.Fields(markupRegexp.ReplaceAllString(text, " ")), " ")
+	const maxLength = 160
+	if runes := []rune(text); len(runes) > maxLength {
+		text = string(runes[:maxLength]) + "…"
+	}
+	return text
+}
+
+// Write writes index as JavaScript file that defines global variable
+// searchIndex.
+func (s *SearchIndex) Write(w io.Writer) error {
+	// terms are sorted, so the generated file does not change when
+	// documents do not change
+	keys := make([]string, 0, len(s.Terms))
+	for term := range s.Terms {
+		keys = append(keys, term)
+	}
+	sort.Strings(keys)
+
+	if _, err := io.WriteString(w, "var searchIndex = {\"entries\": "); err != nil {
+		return err
+	}
+	entries, err := json.Marshal(s.Entries)
+	if err != nil {
+		return err
+	}
+	if _, err := w.Write(entries); err != nil {
+		return err
+	}
+	if _, err := io.WriteString(w, ",\n\"terms\": {"); err != nil {
+		return err
+	}
+	for i, term := range keys {
+		key, err := json.Marshal(term)
+		if err != nil {
+			return err
+		}
+		ids, err := json.Marshal(s.Terms[term])
+		if err != nil {
+			return err
+		}
+		separator := ",\n"
+		if i == 0 {
+			separator = "\n"
+		}
+		if _, err := fmt.Fprintf(w, "%s%s: %s", separator, key, ids); err != nil {
+			return err
+		}
+	}
+	_, err = io.WriteString(w, "\n}};\n")
+	return err
+}
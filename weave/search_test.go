@@ -0,0 +1,98 @@
+/*
+Copyright © 2020 Pavel Tisnovsky
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package weave
+
+import (
+	"reflect"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+func TestStem(t *testing.T) {
+	tests := []struct {
+		word string
+		want string
+	}{
+		{"matice", "matic"},
+		{"matic", "matic"},
+		{"maticemi", "matic"},
+		{"vektorovych", "vektor"},
+		{"vektoru", "vektor"},
+		{"prvkem", "prvk"},
+		{"osa", "osa"},
+		{"dims", "dims"},
+		{"aggregation_sum", "aggregation_sum"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.word, func(t *testing.T) {
+			if got := Stem(tt.word); got != tt.want {
+				t.Errorf("Stem(%q) = %q, want %q", tt.word, got, tt.want)
+			}
+		})
+	}
+}
+
+// scriptSuffixesRegexp matches list of suffixes in search.js.
+var scriptSuffixesRegexp = regexp.MustCompile(`(?s)var suffixes = \[(.*?)\];`)
+
+func TestStemSuffixesMatchScript(t *testing.T) {
+	match := scriptSuffixesRegexp.FindSubmatch(SearchScript)
+	if match == nil {
+		t.Fatal("list of suffixes not found in search.js")
+	}
+	var script []string
+	for _, suffix := range strings.Split(string(match[1]), ",") {
+		script = append(script, strings.Trim(strings.TrimSpace(suffix), `"`))
+	}
+	if !reflect.DeepEqual(script, suffixes) {
+		t.Errorf("suffixes in search.js\n%q\ndiffer from\n%q", script, suffixes)
+	}
+}
+
+func TestWordPatternMatchesScript(t *testing.T) {
+	pattern := wordRegexp.String()
+	if !strings.Contains(string(SearchScript), "/"+pattern+"/gu") {
+		t.Errorf("search.js does not split query by %s", pattern)
+	}
+}
+
+func TestProseTerms(t *testing.T) {
+	tests := []struct {
+		text string
+		want []string
+	}{
+		{"Násobení matic", []string{"nasoben", "matic"}},
+		{"Metoda `Aggregation_SUM` a x", []string{"metod", "aggregation_sum"}},
+		{"Vektor 10x1", []string{"vektor", "10x1"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.text, func(t *testing.T) {
+			if got := ProseTerms(tt.text); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ProseTerms(%q) = %q, want %q", tt.text, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCodeTerms(t *testing.T) {
+	got := CodeTerms("df.Aggregation_SUM(x, mat.NewDense(2, 2, nil))")
+	want := []string{"df", "aggregation_sum", "mat", "newdense", "nil"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("CodeTerms = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,64 @@
+/*
+Copyright © 2020 Pavel Tisnovsky
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package weave
+
+import (
+	"strings"
+	"unicode"
+)
+
+// diacritics maps lowercase letters with diacritical marks used in Czech
+// and Slovak texts to plain letters.
+var diacritics = map[rune]rune{
+	'á': 'a', 'ä': 'a', 'č': 'c', 'ď': 'd', 'é': 'e', 'ě': 'e', 'í': 'i',
+	'ĺ': 'l', 'ľ': 'l', 'ň': 'n', 'ó': 'o', 'ô': 'o', 'ö': 'o', 'ŕ': 'r',
+	'ř': 'r', 'š': 's', 'ť': 't', 'ú': 'u', 'ů': 'u', 'ü': 'u', 'ý': 'y',
+	'ž': 'z',
+}
+
+// Fold converts text to lowercase and removes diacritical marks, so for
+// example "Symetrické" and "symetricke" are the same after folding.
+func Fold(text string) string {
+	return strings.Map(func(r rune) rune {
+		r = unicode.ToLower(r)
+		if plain, found := diacritics[r]; found {
+			return plain
+		}
+		return r
+	}, text)
+}
+
+// Slug converts heading text into identifier usable in URL, for example
+// "Úvodní informace o knihovně Gonum" into
+// "uvodni-informace-o-knihovne-gonum".
+func Slug(text string) string {
+	var b strings.Builder
+	dash := false
+	for _, r := range Fold(text) {
+		switch {
+		case r < unicode.MaxASCII && (unicode.IsLetter(r) || unicode.IsDigit(r)):
+			if dash && b.Len() > 0 {
+				b.WriteByte('-')
+			}
+			b.WriteRune(r)
+			dash = false
+		default:
+			dash = true
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,71 @@
+/*
+Copyright © 2020 Pavel Tisnovsky
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package weave
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestFold(t *testing.T) {
+	tests := []struct {
+		text string
+		want string
+	}{
+		{"Symetrické", "symetricke"},
+		{"PŘÍLIŠ ŽLUŤOUČKÝ KŮŇ ÚPĚL ĎÁBELSKÉ ÓDY", "prilis zlutoucky kun upel dabelske ody"},
+		{"Ľúbostná báseň, kôň a ŕ", "lubostna basen, kon a r"},
+		{"Matrix 2x2", "matrix 2x2"},
+	}
+	for _, tt := range tests {
+		if got := Fold(tt.text); got != tt.want {
+			t.Errorf("Fold(%q) = %q, want %q", tt.text, got, tt.want)
+		}
+	}
+}
+
+func TestSlug(t *testing.T) {
+	tests := []struct {
+		text string
+		want string
+	}{
+		{"Úvodní informace o knihovně Gonum", "uvodni-informace-o-knihovne-gonum"},
+		{"Matice 3×3 (symetrická)", "matice-3-3-symetricka"},
+		{"  Funkce mat.Dense.Mul()  ", "funkce-mat-dense-mul"},
+		{"π", ""},
+	}
+	for _, tt := range tests {
+		if got := Slug(tt.text); got != tt.want {
+			t.Errorf("Slug(%q) = %q, want %q", tt.text, got, tt.want)
+		}
+	}
+}
+
+func TestAnchors(t *testing.T) {
+	_, headings, err := Markdown("# Matice\n\n## Matice\n\n## Matice\n\n## Přílohy\n\n## π\n", newAnchors())
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	for _, heading := range headings {
+		got = append(got, heading.Anchor)
+	}
+	want := []string{"matice", "matice-2", "matice-3", "prilohy", "heading"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("anchors = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,151 @@
+/*
+Copyright © 2020 Pavel Tisnovsky
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package weave
+
+import (
+	"html/template"
+
+	"github.com/tisnik/literate-programming-examples/literate"
+)
+
+// functions available in templates
+var templateFunctions = template.FuncMap{
+	"isOutput": func(item Item) bool { return item.Kind == literate.Output },
+	"outputClass": func(item Item) string {
+		switch {
+		case item.Actual:
+			return "output actual"
+		case !item.Checked:
+			return "output"
+		case item.Result == literate.Match:
+			return "output expected match"
+		case item.Result == literate.LayoutDiffers:
+			return "output expected layout"
+		default:
+			return "output expected mismatch"
+		}
+	},
+}
+
+// pageTemplate is template of woven page.
+var pageTemplate = template.Must(template.New("page").Funcs(templateFunctions).Parse(`<!DOCTYPE html>
+<html{{if .Translations}} lang="{{.Lang}}"{{end}}>
+<head>
+<meta charset="utf-8">
+<meta name="viewport" content="width=device-width, initial-scale=1">
+<meta name="generator" content="literate-programming-examples weave">
+<title>{{.Title}}</title>
+<link rel="stylesheet" href="{{.StyleSheet}}">
+<script src="search-index.js"></script>
+<script src="search.js"></script>
+{{- if .Translations}}
+<style>
+{{- range .Translations}}
+html[data-lang="{{.}}"] .toc.original,
+html[data-lang="{{.}}"] .translated-{{.}} .prose .original { display: none; }
+html[data-lang="{{.}}"] .toc.translation[lang="{{.}}"],
+html[data-lang="{{.}}"] .prose .translation[lang="{{.}}"] { display: block; }
+html[data-lang="{{.}}"] .section:not(.translated-{{.}}) .prose .original.untranslated { border-left: 3px solid #e0a040; padding-left: 8px; }
+{{- end}}
+</style>
+<script>
+// the selected language is remembered for all pages
+(function () {
+    var lang = "";
+    try { lang = localStorage.getItem("literate-lang") || ""; } catch (e) {}
+    document.documentElement.dataset.lang = lang;
+    document.addEventListener("DOMContentLoaded", function () {
+        document.querySelectorAll(".languages button").forEach(function (button) {
+            button.addEventListener("click", function () {
+                var lang = button.dataset.lang;
+                document.documentElement.dataset.lang = lang;
+                try { localStorage.setItem("literate-lang", lang); } catch (e) {}
+            });
+        });
+    });
+})();
+</script>
+{{- end}}
+</head>
+<body>
+<nav class="sidebar">
+<a class="home" href="index.html">Index</a>
+{{- if .Translations}}
+<div class="languages">
+<button type="button" data-lang="">{{.Lang}}</button>
+{{- range .Translations}}
+<button type="button" data-lang="{{.}}">{{.}}</button>
+{{- end}}
+</div>
+{{- end}}
+<form class="search" onsubmit="return false">
+<input type="search" id="search" placeholder="Hledat / Search" autocomplete="off">
+<ol id="search-results"></ol>
+</form>
+<ul class="toc original">
+{{- range .Headings}}
+{{- if le .Level 3}}
+<li class="level-{{.Level}}"><a href="#{{.Anchor}}">{{.Text}}</a></li>
+{{- end}}
+{{- end}}
+</ul>
+{{- range $lang := .Translations}}
+<ul class="toc translation" lang="{{$lang}}">
+{{- range $.HeadingsIn $lang}}
+{{- if le .Level 3}}
+<li class="level-{{.Level}}"><a href="#{{.Anchor}}">{{.Text}}</a></li>
+{{- end}}
+{{- end}}
+</ul>
+{{- end}}
+</nav>
+<main class="literate">
+<header class="source">
+<span class="filename">{{.Source}}</span>
+</header>
+{{- range .Sections}}
+<section class="section{{range .Translations}} translated-{{.Lang}}{{end}}" id="section-{{.Index}}">
+<div class="prose">
+<a class="pilcrow" href="#section-{{.Index}}">¶</a>
+<div class="original{{if and $.Translations .Prose}} untranslated{{end}}">
+{{.Prose}}</div>
+{{- range .Translations}}
+<div class="translation" lang="{{.Lang}}">
+{{.Prose}}</div>
+{{- end}}
+{{- if or .Prev .Next}}
+<nav class="pager">
+{{- with .Prev}}<a class="prev" href="#{{.Anchor}}">‹ {{.Text}}</a>{{end}}
+{{- with .Next}}<a class="next" href="#{{.Anchor}}">{{.Text}} ›</a>{{end}}
+</nav>
+{{- end}}
+</div>
+<div class="code">
+{{- range .Items}}
+{{- if isOutput .}}
+<pre class="{{outputClass .}}"{{with .Note}} title="{{.}}"{{end}}>{{.HTML}}</pre>
+{{- else}}
+<pre class="source"><code>{{.HTML}}</code></pre>
+{{- end}}
+{{- end}}
+</div>
+</section>
+{{- end}}
+</main>
+</body>
+</html>
+`))
@@ -0,0 +1,276 @@
+/*
+Copyright © 2020 Pavel Tisnovsky
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package weave converts literate sources into HTML pages. Prose written
+// in Markdown is displayed next to the code it describes, expected output
+// is displayed below the code. All pages share one style sheet, so Go and
+// Python sources look the same.
+package weave
+
+import (
+	_ "embed"
+	"html"
+	"html/template"
+	"io"
+	"path/filepath"
+	"strings"
+
+	"github.com/tisnik/literate-programming-examples/literate"
+)
+
+// StyleSheet is name of style sheet shared by all woven pages.
+const StyleSheet = "literate.css"
+
+// CSS is content of the shared style sheet, it has to be stored next to
+// woven pages.
+//
+//go:embed literate.css
+var CSS []byte
+
+// SearchScript is implementation of search box, it has to be stored next
+// to woven pages together with search index.
+//
+//go:embed search.js
+var SearchScript []byte
+
+// Item is code or output displayed in the right column.
+type Item struct {
+	Kind literate.Kind
+	Text string
+	HTML template.HTML
+	// Start and End are lines of source, real output has the same lines
+	// as code that printed it.
+	Start int
+	End   int
+	// Actual is set for real output of the program, see Annotate.
+	Actual bool
+	// Checked is set for expected output compared with real output,
+	// Result and Note contain result of the comparison.
+	Checked bool
+	Result  literate.Result
+	Note    string
+}
+
+// Heading is one heading found in prose.
+type Heading struct {
+	Level  int
+	Text   string
+	Anchor string
+}
+
+// Translation is prose of one section translated to another language.
+type Translation struct {
+	Lang     string
+	Markdown string
+	Prose    template.HTML
+	Headings []Heading
+}
+
+// Section is prose together with code and outputs that follow it.
+type Section struct {
+	Index int
+	// Markdown is the original prose, Prose is prose converted to HTML.
+	Markdown     string
+	Prose        template.HTML
+	Headings     []Heading
+	Translations []Translation
+	Items        []Item
+	// Prev and Next are set for sections starting with chapter
+	// heading, they point to the previous and next chapter.
+	Prev *Heading
+	Next *Heading
+	// Start and End are lines of source covered by this section.
+	Start int
+	End   int
+}
+
+// Page is woven literate document.
+type Page struct {
+	Title      string
+	Source     string
+	StyleSheet string
+	// Lang is the default language of prose and Translations are
+	// other languages used in the document.
+	Lang         string
+	Translations []string
+	Sections     []Section
+}
+
+// Weave converts parsed document into page. Lang is the default language
+// of prose written without language marker.
+func Weave(doc *literate.Document, lang string) (*Page, error) {
+	doc.SetDefault(lang)
+	page := &Page{
+		Title:        doc.Title(),
+		Source:       filepath.Base(doc.Filename),
+		StyleSheet:   StyleSheet,
+		Lang:         lang,
+		Translations: doc.Translations(),
+	}
+
+	// headings of the whole document have to be known in advance to
+	// resolve references to sections written before the section itself
+	var all []Heading
+	ids := newAnchors()
+	for _, block := range doc.BlocksOf(literate.Prose) {
+		_, headings, err := Markdown(block.Text(), ids)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, headings...)
+	}
+
+	var current *Section
+	ids = newAnchors()
+	for _, block := range doc.Blocks {
+		// prose starts new section, unless the current section
+		// contains just prose
+		if current == nil || (block.Kind == literate.Prose && len(current.Items) > 0) {
+			page.Sections = append(page.Sections, Section{
+				Index: len(page.Sections),
+				Start: block.Start,
+			})
+			current = &page.Sections[len(page.Sections)-1]
+		}
+		current.End = block.End
+
+		switch block.Kind {
+		case literate.Prose:
+			prose, headings, err := Markdown(ResolveReferences(block.Text(), all), ids)
+			if err != nil {
+				return nil, err
+			}
+			if block.Lang != "" {
+				t := current.translation(block.Lang)
+				t.Markdown += block.Text() + "\n"
+				t.Prose += template.HTML(prose)
+				t.Headings = append(t.Headings, headings...)
+				continue
+			}
+			current.Markdown += block.Text() + "\n"
+			current.Prose += template.HTML(prose)
+			current.Headings = append(current.Headings, headings...)
+		case literate.Code:
+			code := dedent(block.Lines)
+			current.Items = append(current.Items, Item{
+				Kind:  literate.Code,
+				Text:  code,
+				HTML:  template.HTML(Highlight(doc.Language.Name, code)),
+				Start: block.Start,
+				End:   block.End,
+			})
+		case literate.Output:
+			current.Items = append(current.Items, Item{
+				Kind:  literate.Output,
+				Text:  block.Text(),
+				HTML:  template.HTML(html.EscapeString(block.Text())),
+				Start: block.Start,
+				End:   block.End,
+			})
+		}
+	}
+	page.link()
+	return page, nil
+}
+
+// translation returns translation of section to given language, new
+// translation is created when it does not exist.
+func (s *Section) translation(lang string) *Translation {
+	for i := range s.Translations {
+		if s.Translations[i].Lang == lang {
+			return &s.Translations[i]
+		}
+	}
+	s.Translations = append(s.Translations, Translation{Lang: lang})
+	return &s.Translations[len(s.Translations)-1]
+}
+
+// link sets previous and next chapter (heading of the first or second
+// level) for all sections starting with such heading.
+func (p *Page) link() {
+	var chapters []int
+	for i, section := range p.Sections {
+		if len(section.Headings) > 0 && section.Headings[0].Level <= 2 {
+			chapters = append(chapters, i)
+		}
+	}
+	for c, i := range chapters {
+		section := &p.Sections[i]
+		if c > 0 {
+			section.Prev = &p.Sections[chapters[c-1]].Headings[0]
+		}
+		if c < len(chapters)-1 {
+			section.Next = &p.Sections[chapters[c+1]].Headings[0]
+		}
+	}
+}
+
+// Headings returns all headings found in page.
+func (p *Page) Headings() []Heading {
+	var all []Heading
+	for _, section := range p.Sections {
+		all = append(all, section.Headings...)
+	}
+	return all
+}
+
+// HeadingsIn returns headings of page translated to given language.
+// Headings of sections that are not translated are returned in the
+// default language.
+func (p *Page) HeadingsIn(lang string) []Heading {
+	var all []Heading
+	for _, section := range p.Sections {
+		headings := section.Headings
+		for _, t := range section.Translations {
+			if t.Lang == lang && len(t.Headings) > 0 {
+				headings = t.Headings
+			}
+		}
+		all = append(all, headings...)
+	}
+	return all
+}
+
+// dedent removes the common indentation of all non-empty lines, so code
+// from function body is not shifted to the right.
+func dedent(lines []string) string {
+	prefix := ""
+	first := true
+	for _, line := range lines {
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		indent := line[:len(line)-len(strings.TrimLeft(line, " \t"))]
+		if first {
+			prefix = indent
+			first = false
+			continue
+		}
+		for !strings.HasPrefix(indent, prefix) {
+			prefix = prefix[:len(prefix)-1]
+		}
+	}
+	out := make([]string, len(lines))
+	for i, line := range lines {
+		out[i] = strings.TrimPrefix(line, prefix)
+	}
+	return strings.Join(out, "\n")
+}
+
+// Render writes page as HTML document.
+func Render(w io.Writer, page *Page) error {
+	return pageTemplate.Execute(w, page)
+}
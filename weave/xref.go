@@ -0,0 +1,145 @@
+/*
+Copyright © 2020 Pavel Tisnovsky
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package weave
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// referenceRegexp matches phrases used in prose to refer to other sections,
+// for example "viz sekce Matice" or "see section Conclusion".
+var referenceRegexp = regexp.MustCompile(`(?i)\b(?:viz\s+(?:sekce|sekci|kapitola|kapitolu|podkapitola|podkapitolu|část|odstavec)|see\s+(?:section|chapter))\s+`)
+
+// quotes that can surround name of referenced section
+var quotes = []string{"**", "*", "_", "„", "“", "\""}
+
+// ResolveReferences converts references to sections in Markdown prose into
+// links to anchors of the referenced headings. Names of headings are
+// compared without diacritics and case, references in fenced code blocks
+// are not changed.
+func ResolveReferences(prose string, headings []Heading) string {
+	if len(headings) == 0 {
+		return prose
+	}
+	// longer names first, so "Matice" does not hide "Matice a vektory"
+	sorted := append([]Heading(nil), headings...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return len(sorted[i].Text) > len(sorted[j].Text)
+	})
+
+	lines := strings.SplitAfter(prose, "\n")
+	var out strings.Builder
+	var paragraph strings.Builder
+	fence := false
+	flush := func() {
+		out.WriteString(resolveParagraph(paragraph.String(), sorted))
+		paragraph.Reset()
+	}
+	for _, line := range lines {
+		if strings.HasPrefix(strings.TrimSpace(line), "```") {
+			if !fence {
+				flush()
+			}
+			fence = !fence
+			out.WriteString(line)
+			continue
+		}
+		if fence {
+			out.WriteString(line)
+			continue
+		}
+		paragraph.WriteString(line)
+	}
+	flush()
+	return out.String()
+}
+
+// resolveParagraph resolves references in text without fenced code.
+func resolveParagraph(text string, headings []Heading) string {
+	var out strings.Builder
+	last := 0
+	for _, match := range referenceRegexp.FindAllStringIndex(text, -1) {
+		if match[0] < last {
+			continue
+		}
+		start := match[1]
+		quote := ""
+		for _, q := range quotes {
+			if strings.HasPrefix(text[start:], q) {
+				quote = q
+				break
+			}
+		}
+		start += len(quote)
+
+		for _, heading := range headings {
+			n, ok := matchTitle(text[start:], heading.Text)
+			if !ok {
+				continue
+			}
+			out.WriteString(text[last:start])
+			out.WriteString("[" + text[start:start+n] + "](#" + heading.Anchor + ")")
+			last = start + n
+			break
+		}
+	}
+	out.WriteString(text[last:])
+	return out.String()
+}
+
+// matchTitle checks whether text starts with given title and returns the
+// length of matching prefix of text in bytes. Case and diacritical marks
+// are ignored and any sequence of white spaces matches any other.
+func matchTitle(text, title string) (int, bool) {
+	title = Fold(strings.Join(strings.Fields(title), " "))
+	i := 0
+	for _, expected := range title {
+		if i >= len(text) {
+			return 0, false
+		}
+		r, size := utf8.DecodeRuneInString(text[i:])
+		if expected == ' ' {
+			if !unicode.IsSpace(r) {
+				return 0, false
+			}
+			for i < len(text) {
+				r, size = utf8.DecodeRuneInString(text[i:])
+				if !unicode.IsSpace(r) {
+					break
+				}
+				i += size
+			}
+			continue
+		}
+		if Fold(string(r)) != string(expected) {
+			return 0, false
+		}
+		i += size
+	}
+	// the whole word has to match
+	if i < len(text) {
+		r, _ := utf8.DecodeRuneInString(text[i:])
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return 0, false
+		}
+	}
+	return i, true
+}
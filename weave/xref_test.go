@@ -0,0 +1,100 @@
+/*
+Copyright © 2020 Pavel Tisnovsky
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package weave
+
+import "testing"
+
+// testHeadings are headings of page used by all tests.
+var testHeadings = []Heading{
+	{Level: 2, Text: "Matice", Anchor: "matice"},
+	{Level: 2, Text: "Matice a vektory", Anchor: "matice-a-vektory"},
+	{Level: 2, Text: "Symetrické matice", Anchor: "symetricke-matice"},
+	{Level: 2, Text: "Conclusion", Anchor: "conclusion"},
+}
+
+func TestResolveReferences(t *testing.T) {
+	tests := []struct {
+		name  string
+		prose string
+		want  string
+	}{
+		{"Czech", "Podrobnosti viz sekce Matice.",
+			"Podrobnosti viz sekce [Matice](#matice)."},
+		{"English", "For details see section Conclusion.",
+			"For details see section [Conclusion](#conclusion)."},
+		{"longer title first", "Viz kapitola Matice a vektory.",
+			"Viz kapitola [Matice a vektory](#matice-a-vektory)."},
+		{"without diacritics", "viz sekci symetricke matice",
+			"viz sekci [symetricke matice](#symetricke-matice)"},
+		{"quoted", "viz sekce „Matice“",
+			"viz sekce „[Matice](#matice)“"},
+		{"split across lines", "viz sekce Symetrické\nmatice",
+			"viz sekce [Symetrické\nmatice](#symetricke-matice)"},
+		// not resolved
+		{"unknown section", "viz sekce Vektory", "viz sekce Vektory"},
+		{"part of longer word", "viz sekce Maticemi", "viz sekce Maticemi"},
+		{"without reference phrase", "sekce Matice", "sekce Matice"},
+		{"fenced code", "```\nviz sekce Matice\n```\n", "```\nviz sekce Matice\n```\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ResolveReferences(tt.prose, testHeadings); got != tt.want {
+				t.Errorf("ResolveReferences(%q) = %q, want %q", tt.prose, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMatchTitle(t *testing.T) {
+	tests := []struct {
+		text  string
+		title string
+		n     int
+		ok    bool
+	}{
+		{"Matice.", "Matice", len("Matice"), true},
+		{"SYMETRICKÉ  matice", "Symetrické matice", len("SYMETRICKÉ  matice"), true},
+		{"Matice2", "Matice", 0, false},
+		{"Mat", "Matice", 0, false},
+		{"Maticemi", "Matice", 0, false},
+	}
+	for _, tt := range tests {
+		n, ok := matchTitle(tt.text, tt.title)
+		if n != tt.n || ok != tt.ok {
+			t.Errorf("matchTitle(%q, %q) = %d, %v, want %d, %v", tt.text, tt.title, n, ok, tt.n, tt.ok)
+		}
+	}
+}
+
+func TestReferenceRegexp(t *testing.T) {
+	tests := []struct {
+		text string
+		want bool
+	}{
+		{"viz sekce ", true},
+		{"Viz kapitolu ", true},
+		{"see Section ", true},
+		{"see chapter ", true},
+		{"viz obrázek ", false},
+		{"vizsekce ", false},
+	}
+	for _, tt := range tests {
+		if got := referenceRegexp.MatchString(tt.text); got != tt.want {
+			t.Errorf("referenceRegexp.MatchString(%q) = %v, want %v", tt.text, got, tt.want)
+		}
+	}
+}